@@ -1,11 +1,32 @@
 package val
 
 import (
+	"bytes"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math"
+	"net/netip"
+	"net/url"
+	"path"
+	"reflect"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
+	"unicode"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/go-playground/validator/v10/non-standard/validators"
+	"github.com/rivo/uniseg"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+	"golang.org/x/text/language"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 // urlPrefixValidator registers custom validation rules with the validator instance.
@@ -100,3 +121,1194 @@ func fieldSelectorValidator(v *validator.Validate) {
 		return true
 	})
 }
+
+// digestHexLengths maps supported digest algorithms to the length of their hex-encoded value.
+var digestHexLengths = map[string]int{
+	"sha256": 64,
+	"sha512": 128,
+}
+
+// digestValidator registers a custom validation rule "digest" with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must be a content digest in the "<algorithm>:<hex>" form, e.g. "sha256:<64 hex>".
+//   - Supported algorithms are "sha256" (64 hex characters) and "sha512" (128 hex characters).
+//   - The algorithm prefix is optional; a bare hex value must have the length of a supported algorithm.
+//   - Hex characters must be lowercase.
+func digestValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("digest", func(fl validator.FieldLevel) bool {
+		value := fl.Field().String()
+
+		algorithm, encoded, found := strings.Cut(value, ":")
+		if !found {
+			encoded = value
+			if len(encoded) != digestHexLengths["sha256"] && len(encoded) != digestHexLengths["sha512"] {
+				return false
+			}
+			return isLowerHex(encoded)
+		}
+
+		length, ok := digestHexLengths[algorithm]
+		if !ok || len(encoded) != length {
+			return false
+		}
+		return isLowerHex(encoded)
+	})
+}
+
+// isLowerHex reports whether s is a non-empty string made only of lowercase hex characters.
+func isLowerHex(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if (r < '0' || r > '9') && (r < 'a' || r > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// emailLocalPartSpecials lists the non-alphanumeric characters allowed in an unquoted
+// email local part (RFC 5322 atext).
+const emailLocalPartSpecials = "!#$%&'*+/=?^_`{|}~-"
+
+// emailStrictValidator registers a custom validation rule "email_strict" with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must be an address of the form "local@domain" with exactly one "@".
+//   - The local part must be at most 64 characters of unquoted RFC 5322 atext separated by
+//     single dots; quoted local parts, leading, trailing and consecutive dots are rejected.
+//   - The domain must be a valid DNS subdomain (compared case-insensitively).
+func emailStrictValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("email_strict", func(fl validator.FieldLevel) bool {
+		local, domain, found := strings.Cut(fl.Field().String(), "@")
+		if !found || strings.Contains(domain, "@") {
+			return false
+		}
+		if !isStrictLocalPart(local) {
+			return false
+		}
+		return len(validation.IsDNS1123Subdomain(strings.ToLower(domain))) == 0
+	})
+}
+
+// isStrictLocalPart reports whether local is a valid unquoted, dot-separated email local part.
+func isStrictLocalPart(local string) bool {
+	if local == "" || len(local) > 64 {
+		return false
+	}
+	for _, atom := range strings.Split(local, ".") {
+		if atom == "" {
+			return false
+		}
+		for _, r := range atom {
+			if !isAtext(r) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isAtext reports whether r is an RFC 5322 atext character: an ASCII letter, a digit, or one of
+// emailLocalPartSpecials.
+func isAtext(r rune) bool {
+	isAlnum := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+	return isAlnum || strings.ContainsRune(emailLocalPartSpecials, r)
+}
+
+// maxK8sID is the largest user or group ID accepted in Kubernetes security contexts.
+const maxK8sID = math.MaxInt32
+
+// intRange is an inclusive range of integer values.
+type intRange struct {
+	min, max int64
+}
+
+// intRangeTags maps custom validation tags to the inclusive range of integer values they accept.
+var intRangeTags = map[string]intRange{
+	// Security context group IDs (runAsGroup, fsGroup, supplementalGroups).
+	"k8s_gid": {0, maxK8sID},
+	// Security context user IDs (runAsUser).
+	"k8s_uid_num": {0, maxK8sID},
+	// Weight of preferred scheduling terms in node, pod and pod anti-affinity.
+	"k8s_affinity_weight": {1, 100},
+	// Non-negative int32 workload fields such as replicas and revisionHistoryLimit.
+	"k8s_nonneg_int32": {0, math.MaxInt32},
+	// Expiration and TTL seconds such as a Job's ttlSecondsAfterFinished.
+	"k8s_ttl_seconds": {0, math.MaxInt32},
+}
+
+// intRangeValidators registers every tag declared in intRangeTags with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must be a signed or unsigned integer.
+//   - The value must be within the tag's inclusive range.
+func intRangeValidators(v *validator.Validate) {
+	for tag, bounds := range intRangeTags {
+		_ = v.RegisterValidation(tag, func(fl validator.FieldLevel) bool {
+			return inIntRange(fl.Field(), bounds)
+		})
+	}
+}
+
+// inIntRange reports whether field is an integer within bounds.
+func inIntRange(field reflect.Value, bounds intRange) bool {
+	switch field.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return field.Int() >= bounds.min && field.Int() <= bounds.max
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return field.Uint() <= math.MaxInt64 && int64(field.Uint()) >= bounds.min && int64(field.Uint()) <= bounds.max
+	default:
+		return false
+	}
+}
+
+// idRangeValidator registers a custom validation rule "k8s_id_range" with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must be a signed or unsigned integer, as for runAsUser, runAsGroup or fsGroup.
+//   - Without a parameter, it must be within the security context ID range 0..2147483647.
+//   - The parameter overrides the inclusive range as "min:max", e.g. "k8s_id_range=1000:2000".
+//     Negative values are only accepted when min is negative.
+//   - A malformed parameter, or one with min greater than max, rejects every value.
+func idRangeValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("k8s_id_range", func(fl validator.FieldLevel) bool {
+		bounds, ok := parseIDRange(fl.Param())
+		return ok && inIntRange(fl.Field(), bounds)
+	})
+}
+
+// parseIDRange parses a "min:max" parameter of "k8s_id_range", defaulting to 0..maxK8sID when empty.
+func parseIDRange(param string) (intRange, bool) {
+	if param == "" {
+		return intRange{0, maxK8sID}, true
+	}
+
+	minID, maxID, found := strings.Cut(param, ":")
+	lower, minErr := strconv.ParseInt(minID, 10, 64)
+	upper, maxErr := strconv.ParseInt(maxID, 10, 64)
+	if !found || minErr != nil || maxErr != nil || lower > upper {
+		return intRange{}, false
+	}
+	return intRange{lower, upper}, true
+}
+
+// goVersionRegex matches Go release versions without the "go" prefix, e.g. "1.22" or "1.22.3".
+var goVersionRegex = regexp.MustCompile(`^(0|[1-9][0-9]*)\.(0|[1-9][0-9]*)(\.(0|[1-9][0-9]*))?$`)
+
+// goVersionValidator registers a custom validation rule "go_version" with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must be a Go release version of the form "goX.Y" or "goX.Y.Z", e.g. "go1.22.3".
+//   - With the "bare" parameter ("go_version=bare") the prefix is optional and "1.22" is accepted too.
+//   - Major-only versions such as "go1" are rejected.
+func goVersionValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("go_version", func(fl validator.FieldLevel) bool {
+		value := fl.Field().String()
+
+		switch fl.Param() {
+		case "":
+			version, found := strings.CutPrefix(value, "go")
+			return found && goVersionRegex.MatchString(version)
+		case "bare":
+			return goVersionRegex.MatchString(strings.TrimPrefix(value, "go"))
+		default:
+			return false
+		}
+	})
+}
+
+// urlPathValidator registers a custom validation rule "url_path" with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must be an absolute URL path starting with "/".
+//   - It must not contain whitespace or control characters, a query or a fragment.
+//   - Percent-encoded sequences must be well formed, so "%zz" is rejected.
+func urlPathValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("url_path", func(fl validator.FieldLevel) bool {
+		value := fl.Field().String()
+		if !strings.HasPrefix(value, "/") || strings.ContainsAny(value, "?#") {
+			return false
+		}
+		if strings.ContainsFunc(value, func(r rune) bool { return unicode.IsSpace(r) || unicode.IsControl(r) }) {
+			return false
+		}
+
+		u, err := url.Parse(value)
+		if err != nil || u.Scheme != "" || u.Host != "" {
+			return false
+		}
+		_, err = url.PathUnescape(value)
+		return err == nil
+	})
+}
+
+// jwtShapeValidator registers a custom validation rule "jwt_shape" with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must consist of exactly three non-empty segments separated by dots.
+//   - Each segment must be unpadded base64url, as in a compact JWS.
+//   - The signature isn't verified; only the token's shape is checked.
+func jwtShapeValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("jwt_shape", func(fl validator.FieldLevel) bool {
+		segments := strings.Split(fl.Field().String(), ".")
+		if len(segments) != 3 {
+			return false
+		}
+
+		for _, segment := range segments {
+			if segment == "" {
+				return false
+			}
+			if _, err := base64.RawURLEncoding.DecodeString(segment); err != nil {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// selinuxLevelRegex matches SELinux MLS/MCS levels such as "s0", "s0:c1,c2" or "s0-s1:c0.c1023".
+var selinuxLevelRegex = regexp.MustCompile(
+	`^s[0-9]+(:c[0-9]+(\.c[0-9]+)?(,c[0-9]+(\.c[0-9]+)?)*)?(-s[0-9]+(:c[0-9]+(\.c[0-9]+)?(,c[0-9]+(\.c[0-9]+)?)*)?)?$`,
+)
+
+// selinuxLevelValidator registers a custom validation rule "k8s_selinux_level" with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must be an SELinux level: a sensitivity ("s0") optionally followed by a
+//     category set (":c1,c2", with "c0.c1023" ranges), or a "low-high" range of such levels.
+func selinuxLevelValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("k8s_selinux_level", func(fl validator.FieldLevel) bool {
+		return selinuxLevelRegex.MatchString(fl.Field().String())
+	})
+}
+
+// selinuxLabelRegex matches the user, role and type components of an SELinux context, e.g. "container_t".
+var selinuxLabelRegex = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// selinuxLabelValidator registers a custom validation rule "k8s_selinux_label" with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must be an SELinux user, role or type such as "system_u", "system_r" or "container_t":
+//     a non-empty string of lowercase letters, digits and underscores.
+//   - Levels have their own syntax and are validated by "k8s_selinux_level".
+func selinuxLabelValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("k8s_selinux_label", func(fl validator.FieldLevel) bool {
+		return fl.Field().Kind() == reflect.String && selinuxLabelRegex.MatchString(fl.Field().String())
+	})
+}
+
+// apparmorLocalhostPrefix prefixes the name of a profile loaded on the node in an AppArmor profile string.
+const apparmorLocalhostPrefix = "localhost/"
+
+// apparmorProfileValidator registers a custom validation rule "k8s_apparmor_profile" with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must be "runtime/default", "unconfined", or "localhost/<profile>" as used by the
+//     AppArmor annotations, where the profile name is non-empty and has no whitespace.
+//   - "localhost/" alone and other prefixes are rejected.
+func apparmorProfileValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("k8s_apparmor_profile", func(fl validator.FieldLevel) bool {
+		switch value := fl.Field().String(); value {
+		case "runtime/default", "unconfined":
+			return true
+		default:
+			profile, found := strings.CutPrefix(value, apparmorLocalhostPrefix)
+			return found && profile != "" && !strings.ContainsFunc(profile, unicode.IsSpace)
+		}
+	})
+}
+
+// seccompLocalhostPathValidator registers a custom validation rule "k8s_seccomp_localhost_path" with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must be a Localhost seccomp profile path such as "profiles/audit.json", relative
+//     to the kubelet's seccomp root.
+//   - The path must be clean, as returned by path.Clean, and must neither be absolute nor
+//     escape the root with "..". Empty values and "." are rejected.
+func seccompLocalhostPathValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("k8s_seccomp_localhost_path", func(fl validator.FieldLevel) bool {
+		value := fl.Field().String()
+		if value == "" || value == "." || path.IsAbs(value) || path.Clean(value) != value {
+			return false
+		}
+		// A clean path only keeps ".." as leading elements.
+		return value != ".." && !strings.HasPrefix(value, "../")
+	})
+}
+
+// rawObjectValidator registers a custom validation rule "k8s_raw_object" with the provided validator instance.
+//
+// Validation Rule:
+//   - The field must be a byte slice (e.g. json.RawMessage), a string, or a struct holding
+//     the bytes in a `Raw []byte` field (e.g. runtime.RawExtension).
+//   - The bytes must be valid JSON encoding an object ("{...}"); empty input, "null",
+//     arrays and scalars are rejected.
+func rawObjectValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("k8s_raw_object", func(fl validator.FieldLevel) bool {
+		raw, ok := rawBytes(fl.Field())
+		if !ok {
+			return false
+		}
+
+		raw = bytes.TrimSpace(raw)
+		return len(raw) > 0 && raw[0] == '{' && json.Valid(raw)
+	})
+}
+
+// rawBytes extracts the raw JSON bytes of a field validated with "k8s_raw_object".
+func rawBytes(field reflect.Value) ([]byte, bool) {
+	switch field.Kind() {
+	case reflect.String:
+		return []byte(field.String()), true
+	case reflect.Slice:
+		if field.Type().Elem().Kind() == reflect.Uint8 {
+			return field.Bytes(), true
+		}
+	case reflect.Struct:
+		if raw := field.FieldByName("Raw"); raw.IsValid() && raw.Kind() == reflect.Slice && raw.Type().Elem().Kind() == reflect.Uint8 {
+			return raw.Bytes(), true
+		}
+	}
+	return nil, false
+}
+
+// protoIdentRegex matches a protobuf identifier.
+var protoIdentRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// grpcMethodValidator registers a custom validation rule "grpc_method" with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must have the "<package.Service>/<Method>" form, optionally with a leading "/"
+//     as in gRPC's full method names; the package is optional.
+//   - Every dot-separated component and the method must be valid protobuf identifiers.
+//   - With the "strict" parameter ("grpc_method=strict") the service and method names must
+//     also start with an uppercase letter, following the protobuf style guide.
+func grpcMethodValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("grpc_method", func(fl validator.FieldLevel) bool {
+		strict := fl.Param() == "strict"
+		if fl.Param() != "" && !strict {
+			return false
+		}
+
+		service, method, found := strings.Cut(strings.TrimPrefix(fl.Field().String(), "/"), "/")
+		if !found || !protoIdentRegex.MatchString(method) {
+			return false
+		}
+
+		components := strings.Split(service, ".")
+		for _, component := range components {
+			if !protoIdentRegex.MatchString(component) {
+				return false
+			}
+		}
+
+		if strict {
+			serviceName := components[len(components)-1]
+			return unicode.IsUpper(rune(serviceName[0])) && unicode.IsUpper(rune(method[0]))
+		}
+		return true
+	})
+}
+
+// base58Alphabet is the Bitcoin base58 alphabet, which omits 0, O, I and l.
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// base58Validator registers a custom validation rule "base58" with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must be non-empty and only use the Bitcoin base58 alphabet.
+//
+// Base32 values are covered by go-playground's baked-in "base32" tag.
+func base58Validator(v *validator.Validate) {
+	_ = v.RegisterValidation("base58", func(fl validator.FieldLevel) bool {
+		value := fl.Field().String()
+		if value == "" {
+			return false
+		}
+		for _, r := range value {
+			if !strings.ContainsRune(base58Alphabet, r) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// unprivilegedPorts is the range of TCP/UDP ports user workloads may listen on.
+var unprivilegedPorts = intRange{1024, 65535}
+
+// unprivilegedPortValidator registers a custom validation rule "port_unprivileged" with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must be an integer, or a string holding a decimal integer, within 1024..65535.
+//   - Privileged ports (below 1024), 0 and values above 65535 are rejected.
+func unprivilegedPortValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("port_unprivileged", func(fl validator.FieldLevel) bool {
+		field := fl.Field()
+		if field.Kind() != reflect.String {
+			return inIntRange(field, unprivilegedPorts)
+		}
+
+		port, err := strconv.ParseInt(field.String(), 10, 64)
+		return err == nil && port >= unprivilegedPorts.min && port <= unprivilegedPorts.max
+	})
+}
+
+// maxFileMode is the largest permission value accepted by the "file_mode" tag.
+const maxFileMode = 0o777
+
+// fileModeValidator registers a custom validation rule "file_mode" with the provided validator instance.
+//
+// Validation Rule:
+//   - Integers must be within 0..0777 (0..511), as for a volume's defaultMode.
+//   - Strings must be octal permissions such as "0644", "644" or "0o644" within the same range.
+//   - Negative values and values with bits above the permission bits (e.g. 0o1000) are rejected.
+func fileModeValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("file_mode", func(fl validator.FieldLevel) bool {
+		field := fl.Field()
+		if field.Kind() != reflect.String {
+			return inIntRange(field, intRange{0, maxFileMode})
+		}
+
+		value := strings.TrimPrefix(field.String(), "0o")
+		if value == "" {
+			return false
+		}
+		mode, err := strconv.ParseUint(value, 8, 32)
+		return err == nil && mode <= maxFileMode
+	})
+}
+
+// dnsNameRule describes how a Kubernetes name validated by a dnsNameTags tag is checked.
+type dnsNameRule struct {
+	// check returns the list of violations of the name, as the k8s.io/apimachinery validation helpers do.
+	check func(string) []string
+	// allowEmpty accepts an empty value, typically meaning "use the cluster default".
+	allowEmpty bool
+}
+
+// dnsNameTags maps custom validation tags for Kubernetes object name references to their rule.
+var dnsNameTags = map[string]dnsNameRule{
+	// Pod spec.runtimeClassName; empty selects the default runtime.
+	"k8s_runtime_class": {check: validation.IsDNS1123Label, allowEmpty: true},
+	// Pod spec.schedulerName; empty selects the default scheduler.
+	"k8s_scheduler_name": {check: validation.IsDNS1123Subdomain, allowEmpty: true},
+	// IngressClass names and Ingress spec.ingressClassName.
+	"k8s_ingress_class": {check: validation.IsDNS1123Subdomain},
+	// GatewayClass names and Gateway spec.gatewayClassName, named like IngressClasses.
+	"k8s_gateway_class": {check: validation.IsDNS1123Subdomain},
+	// CSIDriver names and CSI volume driver references.
+	"k8s_csi_driver": {check: isCSIDriverName},
+	// StorageClass and VolumeAttributesClass names referenced by PVCs; empty selects the default class.
+	"k8s_storage_class": {check: validation.IsDNS1123Subdomain, allowEmpty: true},
+	// DRA DeviceClass names referenced by ResourceClaim device requests.
+	"k8s_device_class": {check: validation.IsDNS1123Subdomain},
+	// ConfigMap and Secret names referenced by envFrom, env valueFrom and volumes.
+	"k8s_object_name": {check: validation.IsDNS1123Subdomain},
+	// ExternalName Service spec.externalName, a CNAME target.
+	"k8s_external_name": {check: isExternalName},
+}
+
+// maxCSIDriverNameLength is the longest CSI driver name accepted by Kubernetes.
+const maxCSIDriverNameLength = 63
+
+// isCSIDriverName checks a CSI driver name: an RFC 1123 DNS subdomain of at most 63 characters,
+// which also caps every dot-separated segment at 63 characters.
+func isCSIDriverName(value string) []string {
+	errs := validation.IsDNS1123Subdomain(value)
+	if len(value) > maxCSIDriverNameLength {
+		errs = append(errs, validation.MaxLenError(maxCSIDriverNameLength))
+	}
+	return errs
+}
+
+// isExternalName checks an ExternalName Service's CNAME target: an RFC 1123 DNS subdomain that
+// isn't an IP address such as "10.0.0.1", which the subdomain check alone would accept.
+func isExternalName(value string) []string {
+	errs := validation.IsDNS1123Subdomain(value)
+	if _, err := netip.ParseAddr(value); err == nil {
+		errs = append(errs, "must be a DNS name, not an IP address")
+	}
+	return errs
+}
+
+// dnsNameValidators registers every tag declared in dnsNameTags with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must be a string passing the tag's RFC 1123 name check.
+//   - An empty value is accepted only when the tag allows it.
+func dnsNameValidators(v *validator.Validate) {
+	for tag, rule := range dnsNameTags {
+		_ = v.RegisterValidation(tag, func(fl validator.FieldLevel) bool {
+			if fl.Field().Kind() != reflect.String {
+				return false
+			}
+
+			value := fl.Field().String()
+			if value == "" {
+				return rule.allowEmpty
+			}
+			return len(rule.check(value)) == 0
+		})
+	}
+}
+
+// enumTags maps custom enum validation tags to the exact set of values they accept.
+// Matching is case-sensitive, and an empty value is rejected unless it is listed explicitly.
+var enumTags = map[string][]string{
+	// CronJob spec.concurrencyPolicy.
+	"k8s_concurrency_policy": {"Allow", "Forbid", "Replace"},
+	// Admission webhook matchPolicy.
+	"k8s_match_policy": {"Exact", "Equivalent"},
+	// Mutating admission webhook reinvocationPolicy.
+	"k8s_reinvocation_policy": {"Never", "IfNeeded"},
+	// PriorityClass preemptionPolicy.
+	"k8s_preemption_policy": {"PreemptLowerPriority", "Never"},
+	// Pod topologySpreadConstraints whenUnsatisfiable.
+	"k8s_when_unsatisfiable": {"DoNotSchedule", "ScheduleAnyway"},
+	// Pod securityContext fsGroupChangePolicy.
+	"k8s_fsgroup_change_policy": {"Always", "OnRootMismatch"},
+	// Container securityContext procMount.
+	"k8s_proc_mount": {"Default", "Unmasked"},
+	// Service externalTrafficPolicy and internalTrafficPolicy.
+	"k8s_traffic_policy": {"Cluster", "Local"},
+	// Job completionMode.
+	"k8s_completion_mode": {"NonIndexed", "Indexed"},
+	// PersistentVolumeClaim volumeMode.
+	"k8s_volume_mode": {"Filesystem", "Block"},
+	// HostPath volume type; empty skips the host path check.
+	"k8s_hostpath_type": {"", "DirectoryOrCreate", "Directory", "FileOrCreate", "File", "Socket", "CharDevice", "BlockDevice"},
+	// StatefulSet podManagementPolicy.
+	"k8s_pod_management_policy": {"OrderedReady", "Parallel"},
+	// Container imagePullPolicy and image volume pullPolicy.
+	"k8s_pull_policy": {"Always", "IfNotPresent", "Never"},
+	// PodSpec os.name.
+	"k8s_os_name": {"linux", "windows"},
+	// Node taint and toleration effect.
+	"k8s_taint_effect": {"NoSchedule", "PreferNoSchedule", "NoExecute"},
+	// LimitRange limits[].type.
+	"k8s_limit_range_type": {"Pod", "Container", "PersistentVolumeClaim"},
+	// Built-in node condition types.
+	"k8s_node_condition_type": {"Ready", "MemoryPressure", "DiskPressure", "PIDPressure", "NetworkUnavailable"},
+	// Tri-state status of Kubernetes conditions.
+	"k8s_condition_status": {"True", "False", "Unknown"},
+	// Gateway API listener protocol.
+	"gateway_listener_protocol": {"HTTP", "HTTPS", "TLS", "TCP", "UDP"},
+}
+
+// enumValidators registers every tag declared in enumTags with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must be a string equal to one of the values listed for the tag.
+func enumValidators(v *validator.Validate) {
+	for tag, values := range enumTags {
+		allowed := make(map[string]struct{}, len(values))
+		for _, value := range values {
+			allowed[value] = struct{}{}
+		}
+
+		_ = v.RegisterValidation(tag, func(fl validator.FieldLevel) bool {
+			if fl.Field().Kind() != reflect.String {
+				return false
+			}
+			_, ok := allowed[fl.Field().String()]
+			return ok
+		})
+	}
+}
+
+// elementTags maps validation tags for slices to a function returning the index of the first
+// offending element of the field (or -1), so that errors point at that element instead of
+// the whole slice. The second argument is the tag parameter. "subset_of" depends on the enums
+// of the validating instance and is located by Validator.elementLocator instead.
+var elementTags = map[string]func(reflect.Value, string) int{
+	"k8s_label_keys": firstInvalidLabelKey,
+}
+
+// explainTags maps validation tags to a function returning why a value (and tag parameter)
+// was rejected, so that the formatted error includes the underlying parse error.
+var explainTags = map[string]func(reflect.Value, string) error{
+	"bcp47":             parseBCP47,
+	"csv_header":        checkCSVHeader,
+	"slice_max_dive":    checkSliceMax,
+	"go_module_require": checkGoModuleRequire,
+}
+
+// subsetOfValidator registers a custom validation rule "subset_of" with the provided validator instance,
+// looking the named enums up in enums. Validation holds the read lock of the Validator owning
+// enums, so reading them is safe.
+//
+// Validation Rule:
+//   - The field must be a slice or array of strings.
+//   - The tag parameter names an enum registered with RegisterEnum, e.g. "subset_of=features".
+//   - Every element must be one of the enum values; an empty slice is a valid subset.
+//   - Validation fails when the enum isn't registered.
+func subsetOfValidator(v *validator.Validate, enums map[string]map[string]struct{}) {
+	_ = v.RegisterValidation("subset_of", func(fl validator.FieldLevel) bool {
+		field := fl.Field()
+		if field.Kind() != reflect.Slice && field.Kind() != reflect.Array {
+			return false
+		}
+
+		allowed, registered := enums[fl.Param()]
+		return registered && firstNotInEnum(field, allowed) == -1
+	})
+}
+
+// firstNotInEnum returns the index of the first element of the slice or array field
+// that isn't one of the allowed values, or -1 when all elements are.
+func firstNotInEnum(field reflect.Value, allowed map[string]struct{}) int {
+	if field.Kind() != reflect.Slice && field.Kind() != reflect.Array {
+		return -1
+	}
+
+	for i := 0; i < field.Len(); i++ {
+		elem := field.Index(i)
+		if elem.Kind() != reflect.String {
+			return i
+		}
+		if _, found := allowed[elem.String()]; !found {
+			return i
+		}
+	}
+	return -1
+}
+
+// labelKeysValidator registers a custom validation rule "k8s_label_keys" with the provided validator instance.
+//
+// Validation Rule:
+//   - The field must be a slice or array of strings, such as matchLabelKeys or mismatchLabelKeys.
+//   - Every element must be a valid label key (a qualified name with an optional DNS subdomain prefix).
+//   - An empty slice is valid.
+func labelKeysValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("k8s_label_keys", func(fl validator.FieldLevel) bool {
+		field := fl.Field()
+		if field.Kind() != reflect.Slice && field.Kind() != reflect.Array {
+			return false
+		}
+		return firstInvalidLabelKey(field, fl.Param()) == -1
+	})
+}
+
+// firstInvalidLabelKey returns the index of the first element of the slice or array field
+// that isn't a valid label key, or -1 when all elements are valid.
+func firstInvalidLabelKey(field reflect.Value, _ string) int {
+	if field.Kind() != reflect.Slice && field.Kind() != reflect.Array {
+		return -1
+	}
+
+	for i := 0; i < field.Len(); i++ {
+		elem := field.Index(i)
+		if elem.Kind() != reflect.String || len(validation.IsQualifiedName(elem.String())) != 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+// bcp47Validator registers a custom validation rule "bcp47" with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must be a well-formed BCP 47 language tag such as "en-US" or "pt-BR",
+//     as parsed by golang.org/x/text/language.
+//   - Unknown or malformed tags such as "english" are rejected; the formatted
+//     validation error includes the parse error.
+func bcp47Validator(v *validator.Validate) {
+	_ = v.RegisterValidation("bcp47", func(fl validator.FieldLevel) bool {
+		return parseBCP47(fl.Field(), fl.Param()) == nil
+	})
+}
+
+// parseBCP47 parses field as a BCP 47 language tag and returns the parse error, if any.
+func parseBCP47(field reflect.Value, _ string) error {
+	if field.Kind() != reflect.String {
+		return fmt.Errorf("%s is not a string", field.Kind())
+	}
+	_, err := language.Parse(field.String())
+	return err
+}
+
+// csvHeaderValidator registers a custom validation rule "csv_header" with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must be a comma-separated list of column names; surrounding spaces are trimmed.
+//   - Column names must be non-empty and unique, so "a,,b" and "a,a" are rejected.
+//   - The formatted validation error names the offending column.
+func csvHeaderValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("csv_header", func(fl validator.FieldLevel) bool {
+		return checkCSVHeader(fl.Field(), fl.Param()) == nil
+	})
+}
+
+// checkCSVHeader checks that field is a CSV header row with non-empty, unique column names.
+func checkCSVHeader(field reflect.Value, _ string) error {
+	if field.Kind() != reflect.String {
+		return fmt.Errorf("%s is not a string", field.Kind())
+	}
+
+	seen := make(map[string]struct{})
+	for i, column := range strings.Split(field.String(), ",") {
+		column = strings.TrimSpace(column)
+		if column == "" {
+			return fmt.Errorf("column %d has an empty name", i+1)
+		}
+		if _, ok := seen[column]; ok {
+			return fmt.Errorf("column %d duplicates %q", i+1, column)
+		}
+		seen[column] = struct{}{}
+	}
+	return nil
+}
+
+// metav1DurationValidator registers a custom validation rule "k8s_metav1_duration" with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must be a duration as serialized by metav1.Duration, i.e. Go duration
+//     syntax such as "30s", "1h30m" or "-5m".
+//   - The value may also be JSON-quoted, as found in raw manifests.
+//   - Free-form text such as "30 seconds" and empty values are rejected.
+func metav1DurationValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("k8s_metav1_duration", func(fl validator.FieldLevel) bool {
+		value := fl.Field().String()
+		if strings.HasPrefix(value, `"`) {
+			if err := json.Unmarshal([]byte(value), &value); err != nil {
+				return false
+			}
+		}
+
+		_, err := time.ParseDuration(value)
+		return err == nil
+	})
+}
+
+// durationWholeSecondsValidator registers a custom validation rule "duration_whole_seconds" with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must be a Go duration string parsed by time.ParseDuration, such as "2s" or "1m30s".
+//   - The duration must be a whole number of seconds, so "1500ms" is rejected while "2000ms"
+//     is accepted. Timeout fields with second granularity use it.
+func durationWholeSecondsValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("duration_whole_seconds", func(fl validator.FieldLevel) bool {
+		d, err := time.ParseDuration(fl.Field().String())
+		return err == nil && d%time.Second == 0
+	})
+}
+
+// sliceMaxDiveValidator registers a custom validation rule "slice_max_dive" with the provided validator instance.
+//
+// Validation Rule:
+//   - The field must be a slice, array or map with at most N elements, where N is the tag
+//     parameter, e.g. "slice_max_dive=10,dive,required".
+//   - Exceeding the cap yields a single error explaining "too many expressions (max N)"
+//     instead of one error per element.
+//   - Elements are validated by the tags following "dive", whether the cap holds or not: the
+//     errors of a field over its cap are followed by the errors of its elements.
+func sliceMaxDiveValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("slice_max_dive", func(fl validator.FieldLevel) bool {
+		return checkSliceMax(fl.Field(), fl.Param()) == nil
+	})
+}
+
+// checkSliceMax checks that the slice, array or map field has at most param elements.
+func checkSliceMax(field reflect.Value, param string) error {
+	limit, err := strconv.Atoi(param)
+	if err != nil || limit < 0 {
+		return fmt.Errorf("invalid limit %q", param)
+	}
+
+	switch field.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		if field.Len() > limit {
+			return fmt.Errorf("too many expressions (max %d)", limit)
+		}
+		return nil
+	default:
+		return fmt.Errorf("%s is not a slice", field.Kind())
+	}
+}
+
+// fieldRefPaths lists the pod fields the downward API exposes through fieldRef.fieldPath.
+var fieldRefPaths = map[string]struct{}{
+	"metadata.name":           {},
+	"metadata.namespace":      {},
+	"metadata.uid":            {},
+	"metadata.labels":         {},
+	"metadata.annotations":    {},
+	"spec.nodeName":           {},
+	"spec.serviceAccountName": {},
+	"status.hostIP":           {},
+	"status.hostIPs":          {},
+	"status.podIP":            {},
+	"status.podIPs":           {},
+}
+
+// fieldRefSubscriptRegex matches the "metadata.labels['<key>']" and "metadata.annotations['<key>']" forms.
+var fieldRefSubscriptRegex = regexp.MustCompile(`^metadata\.(labels|annotations)\['([^']*)'\]$`)
+
+// fieldRefPathValidator registers a custom validation rule "k8s_field_ref_path" with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must be a downward API fieldRef.fieldPath: one of the pod fields in fieldRefPaths,
+//     or a single label or annotation selected as "metadata.labels['<key>']" or
+//     "metadata.annotations['<key>']".
+//   - The bracketed key must be a valid qualified name.
+func fieldRefPathValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("k8s_field_ref_path", func(fl validator.FieldLevel) bool {
+		value := fl.Field().String()
+		if _, ok := fieldRefPaths[value]; ok {
+			return true
+		}
+
+		match := fieldRefSubscriptRegex.FindStringSubmatch(value)
+		return match != nil && len(validation.IsQualifiedName(match[2])) == 0
+	})
+}
+
+// isoDateLayout is the ISO 8601 calendar date layout, e.g. "2024-01-02".
+const isoDateLayout = "2006-01-02"
+
+// isoDateValidator registers a custom validation rule "date_iso8601" with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must be a calendar date without a time, in the "YYYY-MM-DD" form.
+//   - The date must exist, so "2024-13-40" and "2023-02-29" are rejected.
+//   - Datetimes such as "2024-01-02T15:04:05Z" and other separators like "2024/01/02" are rejected.
+func isoDateValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("date_iso8601", func(fl validator.FieldLevel) bool {
+		_, err := time.Parse(isoDateLayout, fl.Field().String())
+		return err == nil
+	})
+}
+
+var (
+	// imageRegistryRegex matches a registry host with an optional port, e.g. "registry.example.com:5000".
+	imageRegistryRegex = regexp.MustCompile(`^[A-Za-z0-9](?:[A-Za-z0-9-]*[A-Za-z0-9])?(?:\.[A-Za-z0-9](?:[A-Za-z0-9-]*[A-Za-z0-9])?)*(?::[0-9]+)?$`)
+	// imagePathComponentRegex matches a lowercase repository path component, e.g. "my-app" or "team_x".
+	imagePathComponentRegex = regexp.MustCompile(`^[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*$`)
+	// imageTagRegex matches an image tag of at most 128 characters.
+	imageTagRegex = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.-]{0,127}$`)
+)
+
+// maxImageNameLength is the longest repository name, registry included, accepted in an image reference.
+const maxImageNameLength = 255
+
+// containerImageValidator registers a custom validation rule "container_image" with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must be a container image reference "[registry[:port]/]repository[:tag][@digest]".
+//   - The first path component is a registry when it contains "." or ":" or is "localhost".
+//   - Repository path components must be lowercase alphanumerics separated by ".", "_", "__" or dashes.
+//   - A tag must be at most 128 characters of letters, digits, "_", "." and "-", not starting with "." or "-".
+//   - A digest must be "sha256:<64 hex>" or "sha512:<128 hex>".
+func containerImageValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("container_image", func(fl validator.FieldLevel) bool {
+		return isContainerImage(fl.Field().String())
+	})
+}
+
+// isContainerImage reports whether ref is a well-formed container image reference.
+func isContainerImage(ref string) bool {
+	rest, digest, hasDigest := strings.Cut(ref, "@")
+	if hasDigest && !isImageDigest(digest) {
+		return false
+	}
+
+	name, tag, _ := parseImageReference(rest)
+	if name != rest && !imageTagRegex.MatchString(tag) {
+		return false
+	}
+	return isImageName(name)
+}
+
+// isImageName reports whether name is a well-formed image name: an optional registry followed
+// by slash-separated path components.
+func isImageName(name string) bool {
+	if name == "" || len(name) > maxImageNameLength {
+		return false
+	}
+
+	components := strings.Split(name, "/")
+	if first := components[0]; len(components) > 1 && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		if !imageRegistryRegex.MatchString(first) {
+			return false
+		}
+		components = components[1:]
+	}
+	for _, component := range components {
+		if !imagePathComponentRegex.MatchString(component) {
+			return false
+		}
+	}
+	return true
+}
+
+// isImageDigest reports whether digest is an "<algorithm>:<hex>" digest of a supported algorithm.
+func isImageDigest(digest string) bool {
+	algorithm, encoded, found := strings.Cut(digest, ":")
+	length, ok := digestHexLengths[algorithm]
+	return found && ok && len(encoded) == length && isLowerHex(encoded)
+}
+
+// shellSafeRegex matches values that can be substituted into shell scripts without quoting.
+var shellSafeRegex = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// shellSafeValidator registers a custom validation rule "shell_safe" with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must be a non-empty string of ASCII letters, digits, "_", "." and "-" only.
+//   - Whitespace, quotes, ";", "$", "`", "|", "&", redirections, globs and any other shell
+//     metacharacters are rejected, so "a; rm -rf" can't break out of a substitution.
+func shellSafeValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("shell_safe", func(fl validator.FieldLevel) bool {
+		return fl.Field().Kind() == reflect.String && shellSafeRegex.MatchString(fl.Field().String())
+	})
+}
+
+// ipFamilyValidator registers a custom validation rule "ip_family" with the provided validator instance.
+// Unlike go-playground's built-in "ip_addr" tag, it takes a family parameter and accepts zones.
+//
+// Validation Rule:
+//   - The value must be an IPv4 or IPv6 address as parsed by netip.ParseAddr.
+//   - With the "v4" parameter ("ip_family=v4") only IPv4 addresses are accepted; with "v6" only
+//     IPv6 addresses, including IPv4-mapped ones such as "::ffff:10.0.0.1".
+//   - A zone ("fe80::1%eth0") is accepted only on link-local IPv6 addresses.
+func ipFamilyValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("ip_family", func(fl validator.FieldLevel) bool {
+		if fl.Field().Kind() != reflect.String {
+			return false
+		}
+
+		addr, err := netip.ParseAddr(fl.Field().String())
+		if err != nil {
+			return false
+		}
+		if addr.Zone() != "" && !addr.IsLinkLocalUnicast() && !addr.IsLinkLocalMulticast() {
+			return false
+		}
+
+		switch fl.Param() {
+		case "":
+			return true
+		case "v4":
+			return addr.Is4()
+		case "v6":
+			return addr.Is6()
+		default:
+			return false
+		}
+	})
+}
+
+// percentStringRegex matches a non-negative decimal followed by "%", e.g. "50%" or "99.9%".
+var percentStringRegex = regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?)%$`)
+
+// percentStringValidator registers a custom validation rule "percent_string" with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must be a decimal number with an optional fractional part followed by "%", e.g. "99.9%".
+//   - The number must be within 0..100, so "100.1%" is rejected.
+//   - Signs, exponents, whitespace and a missing "%" are rejected.
+func percentStringValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("percent_string", func(fl validator.FieldLevel) bool {
+		match := percentStringRegex.FindStringSubmatch(fl.Field().String())
+		if match == nil {
+			return false
+		}
+
+		percent, err := strconv.ParseFloat(match[1], 64)
+		return err == nil && percent <= 100
+	})
+}
+
+// podCIDRValidator registers a custom validation rule "k8s_pod_cidr" with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must be an IPv4 or IPv6 CIDR such as "10.244.0.0/16", parsed with netip.ParsePrefix.
+//   - The parameter is the accepted prefix length range as "min:max", e.g. "k8s_pod_cidr=8:28";
+//     the prefix length must be within it, so "/32" is rejected for that range.
+//   - A missing or malformed parameter rejects every value.
+func podCIDRValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("k8s_pod_cidr", func(fl validator.FieldLevel) bool {
+		minBits, maxBits, found := strings.Cut(fl.Param(), ":")
+		lower, minErr := strconv.Atoi(minBits)
+		upper, maxErr := strconv.Atoi(maxBits)
+		if !found || minErr != nil || maxErr != nil {
+			return false
+		}
+
+		prefix, err := netip.ParsePrefix(fl.Field().String())
+		if err != nil {
+			return false
+		}
+		return prefix.Bits() >= lower && prefix.Bits() <= upper
+	})
+}
+
+// eventReasonRegex matches an UpperCamelCase token such as "FailedScheduling" or "BackOff".
+var eventReasonRegex = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+
+// maxEventReasonLength is the longest event reason accepted by "k8s_event_reason".
+const maxEventReasonLength = 128
+
+// eventReasonValidator registers a custom validation rule "k8s_event_reason" with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must be an UpperCamelCase token of letters and digits starting with an uppercase
+//     letter, e.g. "FailedScheduling".
+//   - It must be at most 128 characters; spaces and punctuation are rejected.
+func eventReasonValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("k8s_event_reason", func(fl validator.FieldLevel) bool {
+		value := fl.Field().String()
+		return len(value) <= maxEventReasonLength && eventReasonRegex.MatchString(value)
+	})
+}
+
+// requiredNotBlankValidator registers a custom validation rule "required_notblank" with the provided validator instance.
+//
+// Validation Rule:
+//   - Strings must contain at least one non-whitespace character.
+//   - Slices, maps, arrays and channels must not be empty; other values must not be the zero value.
+//   - It uses go-playground's non-standard NotBlank validator.
+func requiredNotBlankValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("required_notblank", validators.NotBlank)
+}
+
+// pemValidator registers a custom validation rule "pem" with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must be a string or byte slice holding one or more PEM blocks, e.g. a certificate
+//     or a CA bundle; only whitespace may surround the blocks.
+//   - The parameter is the required block type ("pem=CERTIFICATE", "pem=RSA PRIVATE KEY"), which
+//     every block must have.
+//   - The block contents aren't parsed.
+func pemValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("pem", func(fl validator.FieldLevel) bool {
+		data, ok := pemBytes(fl.Field())
+		if !ok || fl.Param() == "" {
+			return false
+		}
+		return len(pemBlocks(data, fl.Param())) > 0
+	})
+}
+
+// pemBytes returns the bytes held by a string or byte slice field.
+func pemBytes(field reflect.Value) ([]byte, bool) {
+	switch field.Kind() {
+	case reflect.String:
+		return []byte(field.String()), true
+	case reflect.Slice:
+		if field.Type().Elem().Kind() == reflect.Uint8 {
+			return field.Bytes(), true
+		}
+	}
+	return nil, false
+}
+
+// pemBlocks decodes every PEM block of data. It returns nil unless data holds only PEM blocks
+// of the given type, surrounded by whitespace.
+func pemBlocks(data []byte, blockType string) []*pem.Block {
+	var blocks []*pem.Block
+	for {
+		block, rest := pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != blockType {
+			return nil
+		}
+		blocks = append(blocks, block)
+		data = rest
+	}
+
+	if len(bytes.TrimSpace(data)) > 0 {
+		return nil
+	}
+	return blocks
+}
+
+// x509ValidValidator registers a custom validation rule "x509_valid" with the provided validator instance.
+// now returns the time certificates are checked against.
+//
+// Validation Rule:
+//   - The value must be a string or byte slice holding PEM "CERTIFICATE" blocks, as for "pem=CERTIFICATE".
+//   - Every certificate must parse with crypto/x509 and be valid at now: NotBefore mustn't be in
+//     the future and NotAfter mustn't be in the past.
+func x509ValidValidator(v *validator.Validate, now func() time.Time) {
+	_ = v.RegisterValidation("x509_valid", func(fl validator.FieldLevel) bool {
+		data, ok := pemBytes(fl.Field())
+		if !ok {
+			return false
+		}
+
+		blocks := pemBlocks(data, "CERTIFICATE")
+		if len(blocks) == 0 {
+			return false
+		}
+
+		at := now()
+		for _, block := range blocks {
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil || at.Before(cert.NotBefore) || at.After(cert.NotAfter) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// goModuleRequireValidator registers a custom validation rule "go_module_require" with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must be a go.mod require entry "<module path> <version>", e.g. "example.com/mod v1.2.3".
+//   - The module path must follow the module path rules of golang.org/x/mod/module.
+//   - The version must be canonical semver; pseudo-versions and "+incompatible" are accepted, and
+//     the major version must match the path's suffix, so "example.com/mod v2.0.0" is rejected.
+//   - The formatted validation error includes the reason.
+func goModuleRequireValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("go_module_require", func(fl validator.FieldLevel) bool {
+		return checkGoModuleRequire(fl.Field(), fl.Param()) == nil
+	})
+}
+
+// checkGoModuleRequire checks field as a go.mod require entry and returns the reason it's invalid, if any.
+func checkGoModuleRequire(field reflect.Value, _ string) error {
+	if field.Kind() != reflect.String {
+		return fmt.Errorf("%s is not a string", field.Kind())
+	}
+
+	parts := strings.Fields(field.String())
+	if len(parts) != 2 {
+		return fmt.Errorf("want \"<module path> <version>\", got %d fields", len(parts))
+	}
+
+	modPath, version := parts[0], parts[1]
+	if err := module.Check(modPath, version); err != nil {
+		return err
+	}
+	if base := strings.TrimSuffix(version, "+incompatible"); semver.Canonical(base) != base {
+		return fmt.Errorf("version %q is not canonical", version)
+	}
+	return nil
+}
+
+// graphemesMaxValidator registers a custom validation rule "graphemes_max" with the provided validator instance.
+//
+// Validation Rule:
+//   - The value must have at most N grapheme clusters, where N is the tag parameter, e.g.
+//     "graphemes_max=32". Clusters are counted with uniseg, so an emoji with skin tone
+//     modifiers or a ZWJ sequence such as a family counts as one visible character.
+//   - Unlike the rune-based "max", combining marks don't count towards the limit.
+//   - A missing or negative parameter rejects every value.
+func graphemesMaxValidator(v *validator.Validate) {
+	_ = v.RegisterValidation("graphemes_max", func(fl validator.FieldLevel) bool {
+		limit, err := strconv.Atoi(fl.Param())
+		if err != nil || limit < 0 {
+			return false
+		}
+		return uniseg.GraphemeClusterCount(fl.Field().String()) <= limit
+	})
+}
@@ -0,0 +1,2092 @@
+package val
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcurrencyPolicyValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Allow", "Allow", true},
+		{"Forbid", "Forbid", true},
+		{"Replace", "Replace", true},
+
+		{"Lowercase", "forbid", false},
+		{"Unknown", "Skip", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_concurrency_policy")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestDigestValidator(t *testing.T) {
+	sha256Hex := strings.Repeat("a1", 32)
+	sha512Hex := strings.Repeat("b2", 64)
+
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"SHA256", "sha256:" + sha256Hex, true},
+		{"SHA512", "sha512:" + sha512Hex, true},
+		{"BareSHA256", sha256Hex, true},
+		{"BareSHA512", sha512Hex, true},
+
+		{"SHA256WrongLength", "sha256:" + sha256Hex[:63], false},
+		{"SHA512WithSHA256Length", "sha512:" + sha256Hex, false},
+		{"SHA256Uppercase", "sha256:" + strings.ToUpper(sha256Hex), false},
+		{"SHA512Uppercase", "sha512:" + strings.ToUpper(sha512Hex), false},
+		{"NonHex", "sha256:" + strings.Repeat("zz", 32), false},
+		{"UnknownAlgorithm", "md5:" + sha256Hex, false},
+		{"BareWrongLength", sha256Hex[:40], false},
+		{"EmptyHex", "sha256:", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "digest")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestEmailStrictValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Simple", "john@example.com", true},
+		{"DottedLocalPart", "john.doe@example.com", true},
+		{"PlusTag", "john+tag@mail.example.com", true},
+		{"UppercaseDomain", "John@Example.COM", true},
+
+		{"ConsecutiveDots", "a..b@x.com", false},
+		{"TrailingDot", "a.@x.com", false},
+		{"LeadingDot", ".a@x.com", false},
+		{"QuotedLocalPart", `"john doe"@x.com`, false},
+		{"MultipleAt", "a@b@x.com", false},
+		{"InvalidDomain", "a@-x.com", false},
+		{"EmptyDomain", "a@", false},
+		{"EmptyLocalPart", "@x.com", false},
+		{"NoAt", "x.com", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "email_strict")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+
+	t.Run("stricter than email", func(t *testing.T) {
+		quoted := `"john doe"@x.com`
+
+		assert.NoError(t, ValidateWithTag(quoted, "email"))
+		assert.Error(t, ValidateWithTag(quoted, "email_strict"))
+	})
+}
+
+func TestWebhookPolicyValidators(t *testing.T) {
+	tests := []struct {
+		name  string
+		tag   string
+		input string
+		valid bool
+	}{
+		{"MatchExact", "k8s_match_policy", "Exact", true},
+		{"MatchEquivalent", "k8s_match_policy", "Equivalent", true},
+		{"MatchLowercase", "k8s_match_policy", "exact", false},
+		{"MatchUnknown", "k8s_match_policy", "Fuzzy", false},
+		{"MatchEmpty", "k8s_match_policy", "", false},
+
+		{"ReinvocationNever", "k8s_reinvocation_policy", "Never", true},
+		{"ReinvocationIfNeeded", "k8s_reinvocation_policy", "IfNeeded", true},
+		{"ReinvocationLowercase", "k8s_reinvocation_policy", "ifneeded", false},
+		{"ReinvocationUnknown", "k8s_reinvocation_policy", "Always", false},
+		{"ReinvocationEmpty", "k8s_reinvocation_policy", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, tt.tag)
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestSubsetOfValidator(t *testing.T) {
+	require.NoError(t, RegisterEnum("test-letters", "a", "b", "c"))
+
+	type features struct {
+		Selected []string `validate:"subset_of=test-letters"`
+	}
+
+	tests := []struct {
+		name  string
+		input any
+		valid bool
+	}{
+		{"Subset", []string{"a", "c"}, true},
+		{"FullSet", []string{"a", "b", "c"}, true},
+		{"Empty", []string{}, true},
+		{"Array", [2]string{"b", "a"}, true},
+
+		{"OutOfSet", []string{"a", "z"}, false},
+		{"CaseSensitive", []string{"A"}, false},
+		{"NotSlice", "a", false},
+		{"NotStrings", []int{1}, false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "subset_of=test-letters")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+
+	t.Run("unknown enum", func(t *testing.T) {
+		err := ValidateWithTag([]string{}, "subset_of=test-unknown")
+		require.Error(t, err)
+	})
+
+	t.Run("reports offending element", func(t *testing.T) {
+		expectedErr := "validation failed: string z (subset_of=test-letters)"
+
+		err := ValidateWithTag([]string{"a", "z", "y"}, "subset_of=test-letters")
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+
+	t.Run("reports offending struct element", func(t *testing.T) {
+		expectedErr := "validation failed: features.Selected[1] = \"z\" (subset_of=test-letters)"
+
+		err := ValidateStruct(features{Selected: []string{"a", "z"}})
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+}
+
+func TestPreemptionPolicyValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"PreemptLowerPriority", "PreemptLowerPriority", true},
+		{"Never", "Never", true},
+
+		{"Lowercase", "never", false},
+		{"Unknown", "Always", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_preemption_policy")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestWhenUnsatisfiableValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"DoNotSchedule", "DoNotSchedule", true},
+		{"ScheduleAnyway", "ScheduleAnyway", true},
+
+		{"Lowercase", "donotschedule", false},
+		{"Unknown", "Ignore", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_when_unsatisfiable")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestFSGroupChangePolicyValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Always", "Always", true},
+		{"OnRootMismatch", "OnRootMismatch", true},
+
+		{"Lowercase", "always", false},
+		{"Unknown", "Never", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_fsgroup_change_policy")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestK8sIDValidators(t *testing.T) {
+	tests := []struct {
+		name  string
+		input any
+		valid bool
+	}{
+		{"Zero", 0, true},
+		{"Typical", int64(1000), true},
+		{"Max", int64(2147483647), true},
+		{"Unsigned", uint32(65534), true},
+
+		{"Negative", int64(-1), false},
+		{"OverMax", int64(2147483648), false},
+		{"UnsignedOverMax", uint64(1) << 31, false},
+		{"String", "1000", false},
+	}
+
+	for _, tag := range []string{"k8s_gid", "k8s_uid_num"} {
+		for _, tt := range tests {
+			err := ValidateWithTag(tt.input, tag)
+			if tt.valid {
+				assert.NoError(t, err, tag+" "+tt.name)
+			} else {
+				assert.Error(t, err, tag+" "+tt.name)
+			}
+		}
+	}
+}
+
+func TestGoVersionValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		tag   string
+		input string
+		valid bool
+	}{
+		{"MinorVersion", "go_version", "go1.22", true},
+		{"PatchVersion", "go_version", "go1.22.3", true},
+		{"BareWithPrefix", "go_version=bare", "go1.21.0", true},
+		{"BareWithoutPrefix", "go_version=bare", "1.22", true},
+
+		{"StrictWithoutPrefix", "go_version", "1.22", false},
+		{"MajorOnly", "go_version", "go1", false},
+		{"BareMajorOnly", "go_version=bare", "1", false},
+		{"TooManyParts", "go_version", "go1.22.3.4", false},
+		{"LeadingZero", "go_version", "go1.022", false},
+		{"Arbitrary", "go_version", "latest", false},
+		{"Empty", "go_version", "", false},
+		{"UnknownParam", "go_version=loose", "go1.22", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, tt.tag)
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestURLPathValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Root", "/", true},
+		{"Nested", "/api/v1/pods", true},
+		{"Encoded", "/files/my%20report.pdf", true},
+		{"EncodedSlash", "/a%2Fb", true},
+
+		{"BadEscape", "/files/%zz", false},
+		{"TruncatedEscape", "/files/%2", false},
+		{"RawSpace", "/my report", false},
+		{"Tab", "/a\tb", false},
+		{"Relative", "api/v1", false},
+		{"SchemeRelative", "//example.com/path", false},
+		{"Query", "/search?q=1", false},
+		{"Fragment", "/page#top", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "url_path")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestRuntimeClassValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Valid", "gvisor", true},
+		{"WithDash", "kata-containers", true},
+		{"Empty", "", true},
+
+		{"Uppercase", "gVisor", false},
+		{"Dot", "kata.containers", false},
+		{"Underscore", "run_c", false},
+		{"LeadingDash", "-runc", false},
+		{"TooLong", strings.Repeat("a", 64), false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_runtime_class")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestJWTShapeValidator(t *testing.T) {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"1234567890"}`))
+	signature := base64.RawURLEncoding.EncodeToString([]byte{0xfb, 0xff, 0x01, 0x02})
+
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"ThreeSegments", header + "." + payload + "." + signature, true},
+
+		{"TwoSegments", header + "." + payload, false},
+		{"FourSegments", header + "." + payload + "." + signature + "." + signature, false},
+		{"EmptySignature", header + "." + payload + ".", false},
+		{"StandardBase64", header + "." + payload + ".+/8BAg", false},
+		{"Padded", header + "." + payload + "." + signature + "==", false},
+		{"NonBase64", header + ".not base64!." + signature, false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "jwt_shape")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestProcMountValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Default", "Default", true},
+		{"Unmasked", "Unmasked", true},
+
+		{"Lowercase", "default", false},
+		{"Unknown", "Masked", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_proc_mount")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestSELinuxLevelValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Sensitivity", "s0", true},
+		{"Categories", "s0:c1,c2", true},
+		{"CategoryRange", "s0:c0.c1023", true},
+		{"LevelRange", "s0-s0:c0.c1023", true},
+		{"FullRange", "s0:c1-s1:c1,c5", true},
+
+		{"MissingSensitivity", "c1,c2", false},
+		{"EmptyCategories", "s0:", false},
+		{"TrailingComma", "s0:c1,", false},
+		{"BadCategory", "s0:x1", false},
+		{"Uppercase", "S0", false},
+		{"Spaces", "s0: c1", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_selinux_level")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestRawObjectValidator(t *testing.T) {
+	type rawExtension struct {
+		Raw []byte
+	}
+
+	type rawMessageInput struct {
+		Spec json.RawMessage `validate:"k8s_raw_object"`
+	}
+
+	type rawExtensionInput struct {
+		Spec rawExtension `validate:"k8s_raw_object"`
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Object", `{"replicas":3}`, true},
+		{"EmptyObject", `{}`, true},
+		{"Whitespace", " \n{\"a\": [1, 2]} ", true},
+
+		{"Null", `null`, false},
+		{"Array", `[]`, false},
+		{"Scalar", `"text"`, false},
+		{"Malformed", `{"a":`, false},
+		{"Empty", ``, false},
+	}
+
+	for _, tt := range tests {
+		errMessage := ValidateStruct(rawMessageInput{Spec: json.RawMessage(tt.input)})
+		errExtension := ValidateStruct(rawExtensionInput{Spec: rawExtension{Raw: []byte(tt.input)}})
+		errString := ValidateWithTag(tt.input, "k8s_raw_object")
+		if tt.valid {
+			assert.NoError(t, errMessage, tt.name)
+			assert.NoError(t, errExtension, tt.name)
+			assert.NoError(t, errString, tt.name)
+		} else {
+			assert.Error(t, errMessage, tt.name)
+			assert.Error(t, errExtension, tt.name)
+			assert.Error(t, errString, tt.name)
+		}
+	}
+
+	t.Run("unsupported kind", func(t *testing.T) {
+		require.Error(t, ValidateWithTag(42, "k8s_raw_object"))
+	})
+}
+
+func TestGRPCMethodValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		tag   string
+		input string
+		valid bool
+	}{
+		{"Qualified", "grpc_method", "helloworld.Greeter/SayHello", true},
+		{"NestedPackage", "grpc_method", "google.pubsub.v1.Publisher/Publish", true},
+		{"NoPackage", "grpc_method", "Greeter/SayHello", true},
+		{"LeadingSlash", "grpc_method", "/helloworld.Greeter/SayHello", true},
+		{"LowercaseNonStrict", "grpc_method", "helloworld.greeter/sayHello", true},
+		{"Strict", "grpc_method=strict", "helloworld.Greeter/SayHello", true},
+
+		{"MissingSlash", "grpc_method", "helloworld.Greeter.SayHello", false},
+		{"MissingMethod", "grpc_method", "helloworld.Greeter/", false},
+		{"ExtraSlash", "grpc_method", "helloworld.Greeter/Say/Hello", false},
+		{"EmptyComponent", "grpc_method", "helloworld..Greeter/SayHello", false},
+		{"InvalidIdentifier", "grpc_method", "hello-world.Greeter/SayHello", false},
+		{"DigitStart", "grpc_method", "v1.1Greeter/SayHello", false},
+		{"StrictLowercaseService", "grpc_method=strict", "helloworld.greeter/SayHello", false},
+		{"StrictLowercaseMethod", "grpc_method=strict", "helloworld.Greeter/sayHello", false},
+		{"UnknownParam", "grpc_method=loose", "helloworld.Greeter/SayHello", false},
+		{"Empty", "grpc_method", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, tt.tag)
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestAffinityWeightValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input any
+		valid bool
+	}{
+		{"Min", int32(1), true},
+		{"Middle", 50, true},
+		{"Max", int32(100), true},
+
+		{"Zero", int32(0), false},
+		{"AboveMax", int32(101), false},
+		{"Negative", -1, false},
+		{"String", "50", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_affinity_weight")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestBaseEncodingValidators(t *testing.T) {
+	tests := []struct {
+		name  string
+		tag   string
+		input string
+		valid bool
+	}{
+		{"Base32TOTPSecret", "base32", "JBSWY3DPEHPK3PXP", true},
+		{"Base32Padded", "base32", "MZXW6===", true},
+
+		{"Base32Lowercase", "base32", "jbswy3dpehpk3pxp", false},
+		{"Base32OutOfAlphabet", "base32", "JBSWY3DPEHPK3PX1", false},
+		{"Base32BadPadding", "base32", "MZXW6=", false},
+
+		{"Base58Hash", "base58", "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG", true},
+		{"Base58Address", "base58", "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", true},
+
+		{"Base58Zero", "base58", "1A1zP1eP5QGefi2DMPTfTL5SLmv7Div0Na", false},
+		{"Base58UppercaseO", "base58", "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdO", false},
+		{"Base58UppercaseI", "base58", "I", false},
+		{"Base58LowercaseL", "base58", "l", false},
+		{"Base58Symbol", "base58", "abc+def", false},
+		{"Base58Empty", "base58", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, tt.tag)
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestLabelKeysValidator(t *testing.T) {
+	type affinityTerm struct {
+		MatchLabelKeys []string `validate:"k8s_label_keys"`
+	}
+
+	tests := []struct {
+		name  string
+		input any
+		valid bool
+	}{
+		{"Simple", []string{"app", "pod-template-hash"}, true},
+		{"Prefixed", []string{"app.kubernetes.io/name", "example.com/tier"}, true},
+		{"Empty", []string{}, true},
+
+		{"Space", []string{"app", "my key"}, false},
+		{"EmptyKey", []string{""}, false},
+		{"BadPrefix", []string{"Example.com/tier"}, false},
+		{"TooLongName", []string{strings.Repeat("a", 64)}, false},
+		{"NotSlice", "app", false},
+		{"NotStrings", []int{1}, false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_label_keys")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+
+	t.Run("reports offending index", func(t *testing.T) {
+		expectedErr := "validation failed: affinityTerm.MatchLabelKeys[2] = \"my key\" (k8s_label_keys=)"
+
+		err := ValidateStruct(affinityTerm{MatchLabelKeys: []string{"app", "tier", "my key"}})
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+
+	t.Run("reports offending element", func(t *testing.T) {
+		expectedErr := "validation failed: string my key (k8s_label_keys=)"
+
+		err := ValidateWithTag([]string{"app", "my key"}, "k8s_label_keys")
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+}
+
+func TestNonNegInt32Validator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input any
+		valid bool
+	}{
+		{"Zero", int32(0), true},
+		{"Replicas", int32(3), true},
+		{"Int32Max", int64(math.MaxInt32), true},
+		{"Unsigned", uint(10), true},
+
+		{"Negative", int32(-1), false},
+		{"AboveInt32", int64(math.MaxInt32) + 1, false},
+		{"String", "3", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_nonneg_int32")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestBCP47Validator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Language", "en", true},
+		{"LanguageRegion", "en-US", true},
+		{"Portuguese", "pt-BR", true},
+		{"Script", "zh-Hant-TW", true},
+
+		{"Word", "english", false},
+		{"Underscore", "en_US!", false},
+		{"TrailingDash", "en-", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "bcp47")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+
+	t.Run("surfaces parse error", func(t *testing.T) {
+		expectedErr := `validation failed: string english (bcp47=): language: tag is not well-formed`
+
+		err := ValidateWithTag("english", "bcp47")
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+}
+
+func TestTrafficPolicyValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Cluster", "Cluster", true},
+		{"Local", "Local", true},
+
+		{"Lowercase", "local", false},
+		{"Unknown", "Global", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_traffic_policy")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestFileModeValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input any
+		valid bool
+	}{
+		{"IntDefaultMode", int32(0o644), true},
+		{"IntZero", 0, true},
+		{"IntMax", 0o777, true},
+		{"StringOctal", "0644", true},
+		{"StringWithoutZero", "755", true},
+		{"StringGoPrefix", "0o600", true},
+
+		{"IntAboveMax", 0o1000, false},
+		{"IntNegative", -1, false},
+		{"StringAboveMax", "0o1000", false},
+		{"StringNotOctal", "0648", false},
+		{"StringNegative", "-644", false},
+		{"StringEmpty", "", false},
+		{"Bool", true, false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "file_mode")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestCompletionModeValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"NonIndexed", "NonIndexed", true},
+		{"Indexed", "Indexed", true},
+
+		{"Lowercase", "indexed", false},
+		{"Unknown", "Sequential", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_completion_mode")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestCSVHeaderValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Single", "id", true},
+		{"Multiple", "id,name,email", true},
+		{"Spaces", "id, name , email", true},
+
+		{"EmptyColumn", "a,,b", false},
+		{"TrailingComma", "a,b,", false},
+		{"BlankColumn", "a, ,b", false},
+		{"Duplicate", "a,a", false},
+		{"DuplicateAfterTrim", "a, b,b ", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "csv_header")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+
+	t.Run("reports empty column", func(t *testing.T) {
+		expectedErr := "validation failed: string a,,b (csv_header=): column 2 has an empty name"
+
+		err := ValidateWithTag("a,,b", "csv_header")
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+
+	t.Run("reports duplicate column", func(t *testing.T) {
+		type importSpec struct {
+			Header string `validate:"csv_header"`
+		}
+		expectedErr := `validation failed: importSpec.Header = "a,b,a" (csv_header=): column 3 duplicates "a"`
+
+		err := ValidateStruct(importSpec{Header: "a,b,a"})
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+}
+
+func TestMetav1DurationValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Seconds", "30s", true},
+		{"Compound", "1h30m", true},
+		{"Fractional", "1.5s", true},
+		{"Negative", "-5m", true},
+		{"Zero", "0s", true},
+		{"JSONQuoted", `"30s"`, true},
+
+		{"Words", "30 seconds", false},
+		{"NoUnit", "30", false},
+		{"UnknownUnit", "3d", false},
+		{"JSONQuotedInvalid", `"30 seconds"`, false},
+		{"UnterminatedQuote", `"30s`, false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_metav1_duration")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestSliceMaxDiveValidator(t *testing.T) {
+	type policy struct {
+		Expressions []string `validate:"slice_max_dive=3,dive,required"`
+	}
+
+	t.Run("within cap", func(t *testing.T) {
+		err := ValidateStruct(policy{Expressions: []string{"a > 1", "b < 2", "c == 3"}})
+		require.NoError(t, err)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		err := ValidateStruct(policy{})
+		require.NoError(t, err)
+	})
+
+	t.Run("too many elements", func(t *testing.T) {
+		expectedErr := "validation failed: policy.Expressions (slice_max_dive=3): too many expressions (max 3)"
+
+		err := ValidateStruct(policy{Expressions: []string{"a", "b", "c", "d"}})
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+
+	t.Run("element validation over cap", func(t *testing.T) {
+		expectedErr := "validation failed: policy.Expressions (slice_max_dive=3): too many expressions (max 3), " +
+			"policy.Expressions[4] = \"\" (required=)"
+
+		err := ValidateStruct(policy{Expressions: []string{"a", "b", "c", "d", ""}})
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+
+		fieldErrs, ok := AsFieldErrors(err)
+		require.True(t, ok)
+		require.Len(t, fieldErrs, 2)
+		assert.Equal(t, "policy.Expressions[4]", fieldErrs[1].Field)
+		assert.Equal(t, "required", fieldErrs[1].Tag)
+	})
+
+	t.Run("nested and renamed fields", func(t *testing.T) {
+		type rule struct {
+			Expressions []string `json:"expressions" binding:"slice_max_dive=1,dive,required"`
+		}
+		type spec struct {
+			Rules []rule `binding:"dive"`
+		}
+		scoped := NewValidator(WithTagName("binding"))
+		expectedErr := "validation failed: spec.Rules[0].expressions (slice_max_dive=1): too many expressions (max 1), " +
+			"spec.Rules[0].expressions[0] = \"\" (required=)"
+
+		err := scoped.ValidateStruct(spec{Rules: []rule{{Expressions: []string{"", "a"}}}})
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+
+	t.Run("variable over cap", func(t *testing.T) {
+		expectedErr := "validation failed: []string [a  c] (slice_max_dive=2): too many expressions (max 2), [1] = \"\" (required=)"
+
+		err := ValidateWithTag([]string{"a", "", "c"}, "slice_max_dive=2,dive,required")
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+
+	t.Run("element validation within cap", func(t *testing.T) {
+		expectedErr := "validation failed: policy.Expressions[1] = \"\" (required=)"
+
+		err := ValidateStruct(policy{Expressions: []string{"a > 1", ""}})
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+
+	t.Run("map", func(t *testing.T) {
+		require.NoError(t, ValidateWithTag(map[string]int{"a": 1}, "slice_max_dive=1"))
+		require.Error(t, ValidateWithTag(map[string]int{"a": 1, "b": 2}, "slice_max_dive=1"))
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		require.Error(t, ValidateWithTag("abc", "slice_max_dive=5"))
+		require.Error(t, ValidateWithTag([]string{}, "slice_max_dive=many"))
+	})
+}
+
+func TestFieldRefPathValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Name", "metadata.name", true},
+		{"Namespace", "metadata.namespace", true},
+		{"NodeName", "spec.nodeName", true},
+		{"PodIP", "status.podIP", true},
+		{"AllLabels", "metadata.labels", true},
+		{"LabelKey", "metadata.labels['app']", true},
+		{"PrefixedLabelKey", "metadata.labels['app.kubernetes.io/name']", true},
+		{"AnnotationKey", "metadata.annotations['example.com/owner']", true},
+
+		{"Replicas", "spec.replicas", false},
+		{"DoubleQuotedKey", `metadata.labels["app"]`, false},
+		{"EmptyKey", "metadata.labels['']", false},
+		{"InvalidKey", "metadata.labels['my key']", false},
+		{"UnknownMap", "metadata.finalizers['x']", false},
+		{"UnterminatedBracket", "metadata.labels['app'", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_field_ref_path")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestSchedulerNameValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Default", "default-scheduler", true},
+		{"Subdomain", "scheduler.example.com", true},
+		{"Empty", "", true},
+
+		{"Uppercase", "My-Scheduler", false},
+		{"Underscore", "my_scheduler", false},
+		{"TrailingDot", "scheduler.", false},
+		{"TooLong", strings.Repeat("a", 254), false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_scheduler_name")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestVolumeModeValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Filesystem", "Filesystem", true},
+		{"Block", "Block", true},
+
+		{"Lowercase", "block", false},
+		{"Unknown", "Raw", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_volume_mode")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestUnprivilegedPortValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input any
+		valid bool
+	}{
+		{"Min", 1024, true},
+		{"Typical", int32(8080), true},
+		{"Max", uint16(65535), true},
+		{"StringMin", "1024", true},
+		{"StringMax", "65535", true},
+
+		{"HTTP", 80, false},
+		{"Zero", 0, false},
+		{"BelowMin", 1023, false},
+		{"AboveMax", 65536, false},
+		{"Negative", -8080, false},
+		{"StringHTTP", "80", false},
+		{"StringAboveMax", "65536", false},
+		{"StringNotNumeric", "http", false},
+		{"StringEmpty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "port_unprivileged")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestISODateValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Date", "2024-01-02", true},
+		{"LeapDay", "2024-02-29", true},
+		{"EndOfYear", "1999-12-31", true},
+
+		{"InvalidMonthAndDay", "2024-13-40", false},
+		{"NonLeapDay", "2023-02-29", false},
+		{"DateTime", "2024-01-02T15:04:05Z", false},
+		{"DateWithSpaceTime", "2024-01-02 15:04", false},
+		{"Slashes", "2024/01/02", false},
+		{"SingleDigitMonth", "2024-1-02", false},
+		{"Compact", "20240102", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "date_iso8601")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestHostPathTypeValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Empty", "", true},
+		{"DirectoryOrCreate", "DirectoryOrCreate", true},
+		{"Directory", "Directory", true},
+		{"FileOrCreate", "FileOrCreate", true},
+		{"File", "File", true},
+		{"Socket", "Socket", true},
+		{"CharDevice", "CharDevice", true},
+		{"BlockDevice", "BlockDevice", true},
+
+		{"Lowercase", "directory", false},
+		{"Unknown", "Symlink", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_hostpath_type")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestPodManagementPolicyValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"OrderedReady", "OrderedReady", true},
+		{"Parallel", "Parallel", true},
+
+		{"Lowercase", "parallel", false},
+		{"Unknown", "Serial", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_pod_management_policy")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestTTLSecondsValidator(t *testing.T) {
+	ttl := int32(3600)
+
+	tests := []struct {
+		name  string
+		input any
+		valid bool
+	}{
+		{"Zero", int32(0), true},
+		{"Hour", int32(3600), true},
+		{"Pointer", &ttl, true},
+		{"Int32Max", int64(math.MaxInt32), true},
+
+		{"Negative", int32(-1), false},
+		{"AboveInt32", int64(math.MaxInt32) + 1, false},
+		{"String", "3600", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_ttl_seconds")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestPullPolicyValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Always", "Always", true},
+		{"IfNotPresent", "IfNotPresent", true},
+		{"Never", "Never", true},
+
+		{"Lowercase", "always", false},
+		{"Unknown", "Sometimes", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_pull_policy")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestContainerImageValidator(t *testing.T) {
+	digest := "sha256:" + strings.Repeat("a", 64)
+
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Name", "nginx", true},
+		{"Tag", "nginx:1.27-alpine", true},
+		{"Namespace", "library/nginx:latest", true},
+		{"Registry", "ghcr.io/kaudit/val:v1.2.3", true},
+		{"RegistryPort", "registry.example.com:5000/team/app", true},
+		{"Localhost", "localhost/app:dev", true},
+		{"Digest", "nginx@" + digest, true},
+		{"TagAndDigest", "nginx:1.27@" + digest, true},
+
+		{"Empty", "", false},
+		{"Uppercase", "Nginx", false},
+		{"EmptyTag", "nginx:", false},
+		{"TagStartsWithDot", "nginx:.1", false},
+		{"LongTag", "nginx:" + strings.Repeat("a", 129), false},
+		{"ShortDigest", "nginx@sha256:abc", false},
+		{"BareDigest", "nginx@" + strings.Repeat("a", 64), false},
+		{"Whitespace", "nginx latest", false},
+		{"EmptyComponent", "library//nginx", false},
+		{"BadRegistryPort", "registry.example.com:port/app", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "container_image")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestOSNameValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"linux", "linux", true},
+		{"windows", "windows", true},
+
+		{"Lowercase", "Linux", false},
+		{"Unknown", "darwin", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_os_name")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestShellSafeValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Identifier", "MY_VAR", true},
+		{"Version", "v1.2.3-rc.1", true},
+		{"Hostname", "db-0.cluster.local", true},
+
+		{"Command", "a; rm -rf", false},
+		{"Space", "a b", false},
+		{"SingleQuote", "it's", false},
+		{"DoubleQuote", `"a"`, false},
+		{"Backtick", "`id`", false},
+		{"Substitution", "$(id)", false},
+		{"Pipe", "a|b", false},
+		{"Newline", "a\nb", false},
+		{"NonASCII", "café", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "shell_safe")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestIngressClassValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Name", "nginx", true},
+		{"Subdomain", "internal.example.com", true},
+
+		{"Empty", "", false},
+		{"Uppercase", "Nginx", false},
+		{"Underscore", "public_lb", false},
+		{"TooLong", strings.Repeat("a", 254), false},
+	}
+
+	for _, tag := range []string{"k8s_ingress_class", "k8s_gateway_class"} {
+		for _, tt := range tests {
+			err := ValidateWithTag(tt.input, tag)
+			if tt.valid {
+				assert.NoError(t, err, tag+" "+tt.name)
+			} else {
+				assert.Error(t, err, tag+" "+tt.name)
+			}
+		}
+	}
+}
+
+func TestCSIDriverValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Driver", "ebs.csi.aws.com", true},
+		{"Label", "hostpath", true},
+		{"MaxLength", strings.Repeat("a", 30) + "." + strings.Repeat("b", 32), true},
+
+		{"Empty", "", false},
+		{"Uppercase", "EBS.csi.aws.com", false},
+		{"LongSegment", strings.Repeat("a", 64) + ".csi.io", false},
+		{"TooLong", strings.Repeat("a", 32) + "." + strings.Repeat("b", 31), false},
+		{"Underscore", "my_driver.io", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_csi_driver")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestSELinuxLabelValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"User", "system_u", true},
+		{"Role", "system_r", true},
+		{"Type", "container_t", true},
+		{"Digits", "spc_t2", true},
+
+		{"Uppercase", "Container_t", false},
+		{"Space", "container t", false},
+		{"Colon", "system_u:system_r", false},
+		{"Dash", "container-t", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_selinux_label")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestIPFamilyValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		tag   string
+		input string
+		valid bool
+	}{
+		{"AnyV4", "ip_family", "10.0.0.1", true},
+		{"AnyV6", "ip_family", "2001:db8::1", true},
+		{"V4", "ip_family=v4", "192.168.1.10", true},
+		{"V6", "ip_family=v6", "::1", true},
+		{"V6Mapped", "ip_family=v6", "::ffff:10.0.0.1", true},
+		{"ZonedLinkLocal", "ip_family=v6", "fe80::1%eth0", true},
+		{"ZonedLinkLocalAny", "ip_family", "fe80::1%eth0", true},
+
+		{"V6AsV4", "ip_family=v4", "2001:db8::1", false},
+		{"V4AsV6", "ip_family=v6", "10.0.0.1", false},
+		{"ZonedLinkLocalAsV4", "ip_family=v4", "fe80::1%eth0", false},
+		{"ZonedGlobal", "ip_family=v6", "2001:db8::1%eth0", false},
+		{"EmptyZone", "ip_family=v6", "fe80::1%", false},
+		{"Hostname", "ip_family", "localhost", false},
+		{"CIDR", "ip_family", "10.0.0.0/8", false},
+		{"Empty", "ip_family", "", false},
+		{"UnknownParam", "ip_family=v5", "10.0.0.1", false},
+
+		// The built-in ip_addr tag is left unchanged and still rejects zones.
+		{"BuiltInZoned", "ip_addr", "fe80::1%eth0", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, tt.tag)
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestStorageClassValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Name", "standard", true},
+		{"Subdomain", "gp3.ebs.csi.aws.com", true},
+		{"Empty", "", true},
+
+		{"Uppercase", "Standard", false},
+		{"Underscore", "fast_ssd", false},
+		{"TooLong", strings.Repeat("a", 254), false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_storage_class")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestPercentStringValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Integer", "50%", true},
+		{"Fractional", "99.9%", true},
+		{"ManyDecimals", "99.995%", true},
+		{"Zero", "0%", true},
+		{"Hundred", "100%", true},
+		{"HundredFractional", "100.0%", true},
+
+		{"AboveHundred", "100.1%", false},
+		{"Word", "abc%", false},
+		{"NoPercent", "50", false},
+		{"Negative", "-5%", false},
+		{"LeadingDot", ".5%", false},
+		{"TrailingDot", "5.%", false},
+		{"Space", "50 %", false},
+		{"PercentOnly", "%", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "percent_string")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestPodCIDRValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		tag   string
+		input string
+		valid bool
+	}{
+		{"Default", "k8s_pod_cidr=8:28", "10.244.0.0/16", true},
+		{"Widest", "k8s_pod_cidr=8:28", "10.0.0.0/8", true},
+		{"Narrowest", "k8s_pod_cidr=8:28", "192.168.1.16/28", true},
+		{"IPv6", "k8s_pod_cidr=48:112", "fd00:10:244::/56", true},
+
+		{"HostPrefix", "k8s_pod_cidr=8:28", "10.244.0.1/32", false},
+		{"TooWide", "k8s_pod_cidr=8:28", "10.0.0.0/7", false},
+		{"NoPrefix", "k8s_pod_cidr=8:28", "10.244.0.0", false},
+		{"BadPrefix", "k8s_pod_cidr=8:28", "10.244.0.0/33", false},
+		{"NotCIDR", "k8s_pod_cidr=8:28", "pods", false},
+		{"Empty", "k8s_pod_cidr=8:28", "", false},
+		{"MissingParam", "k8s_pod_cidr", "10.244.0.0/16", false},
+		{"MalformedParam", "k8s_pod_cidr=8-28", "10.244.0.0/16", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, tt.tag)
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestEventReasonValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Reason", "FailedScheduling", true},
+		{"Short", "Killing", true},
+		{"Digits", "Http2Error", true},
+		{"MaxLength", "A" + strings.Repeat("b", 127), true},
+
+		{"Space", "some reason", false},
+		{"Lowercase", "failedScheduling", false},
+		{"Dash", "Back-Off", false},
+		{"TooLong", "A" + strings.Repeat("b", 128), false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_event_reason")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestRequiredNotBlankValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input any
+		valid bool
+	}{
+		{"Message", "Successfully pulled image", true},
+		{"PaddedMessage", "  ok  ", true},
+		{"Slice", []string{""}, true},
+
+		{"Empty", "", false},
+		{"Spaces", "   ", false},
+		{"Whitespace", "\t\n", false},
+		{"EmptySlice", []string{}, false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "required_notblank")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+
+	t.Run("event", func(t *testing.T) {
+		type event struct {
+			Reason  string `validate:"k8s_event_reason"`
+			Message string `validate:"required_notblank"`
+		}
+		expectedErr := "validation failed: event.Reason = \"some reason\" (k8s_event_reason=), event.Message = \" \" (required_notblank=)"
+
+		require.NoError(t, ValidateStruct(event{Reason: "Pulled", Message: "Pulled image nginx"}))
+
+		err := ValidateStruct(event{Reason: "some reason", Message: " "})
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+}
+
+// testCertificatePEM returns a PEM-encoded self-signed certificate valid from notBefore to notAfter,
+// and the PEM-encoded RSA private key that signed it.
+func testCertificatePEM(t *testing.T, notBefore, notAfter time.Time) (certPEM, keyPEM []byte) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "val.test"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM = pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	return certPEM, keyPEM
+}
+
+func TestPEMValidator(t *testing.T) {
+	certPEM, keyPEM := testCertificatePEM(t, time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	bundle := string(certPEM) + string(certPEM)
+
+	tests := []struct {
+		name  string
+		tag   string
+		input any
+		valid bool
+	}{
+		{"Certificate", "pem=CERTIFICATE", string(certPEM), true},
+		{"CertificateBytes", "pem=CERTIFICATE", certPEM, true},
+		{"Bundle", "pem=CERTIFICATE", bundle, true},
+		{"PaddedCertificate", "pem=CERTIFICATE", "\n" + string(certPEM) + "\n", true},
+		{"RSAKey", "pem=RSA PRIVATE KEY", string(keyPEM), true},
+
+		{"KeyAsCertificate", "pem=CERTIFICATE", string(keyPEM), false},
+		{"CertificateAsKey", "pem=RSA PRIVATE KEY", string(certPEM), false},
+		{"MixedBlocks", "pem=CERTIFICATE", string(certPEM) + string(keyPEM), false},
+		{"TrailingGarbage", "pem=CERTIFICATE", string(certPEM) + "garbage", false},
+		{"NotPEM", "pem=CERTIFICATE", "not a certificate", false},
+		{"Empty", "pem=CERTIFICATE", "", false},
+		{"MissingParam", "pem", string(certPEM), false},
+		{"NotString", "pem=CERTIFICATE", 42, false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, tt.tag)
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestX509ValidValidator(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	scoped := NewValidator(WithClock(clock))
+
+	validPEM, keyPEM := testCertificatePEM(t, now.Add(-time.Minute), now.Add(time.Minute))
+	expiredPEM, _ := testCertificatePEM(t, now.Add(-2*time.Minute), now.Add(-time.Minute))
+	futurePEM, _ := testCertificatePEM(t, now.Add(time.Minute), now.Add(2*time.Minute))
+
+	tests := []struct {
+		name  string
+		input any
+		valid bool
+	}{
+		{"Valid", string(validPEM), true},
+		{"ValidBytes", validPEM, true},
+		{"ValidBundle", string(validPEM) + string(validPEM), true},
+
+		{"Expired", string(expiredPEM), false},
+		{"NotYetValid", string(futurePEM), false},
+		{"BundleWithExpired", string(validPEM) + string(expiredPEM), false},
+		{"Key", string(keyPEM), false},
+		{"CorruptCertificate", "-----BEGIN CERTIFICATE-----\nAAAA\n-----END CERTIFICATE-----\n", false},
+		{"NotPEM", "not a certificate", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := scoped.ValidateWithTag(tt.input, "x509_valid")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+
+	t.Run("default clock", func(t *testing.T) {
+		require.Error(t, ValidateWithTag(string(validPEM), "x509_valid"))
+
+		current, _ := testCertificatePEM(t, time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+		require.NoError(t, ValidateWithTag(string(current), "x509_valid"))
+	})
+}
+
+func TestDeviceClassValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Name", "gpu", true},
+		{"Subdomain", "gpu.example.com", true},
+
+		{"Empty", "", false},
+		{"Uppercase", "GPU.example.com", false},
+		{"Underscore", "fast_gpu", false},
+		{"TooLong", strings.Repeat("a", 254), false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_device_class")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestTaintEffectValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"NoSchedule", "NoSchedule", true},
+		{"PreferNoSchedule", "PreferNoSchedule", true},
+		{"NoExecute", "NoExecute", true},
+
+		{"Lowercase", "noSchedule", false},
+		{"Unknown", "NoExecuteNow", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_taint_effect")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestGoModuleRequireValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Release", "example.com/mod v1.2.3", true},
+		{"MajorSuffix", "github.com/go-playground/validator/v10 v10.26.0", true},
+		{"PreRelease", "example.com/mod v1.3.0-rc.1", true},
+		{"PseudoVersion", "golang.org/x/text v0.0.0-20250101120000-abcdef123456", true},
+		{"Incompatible", "github.com/docker/docker v20.10.7+incompatible", true},
+		{"Tab", "example.com/mod\tv1.2.3", true},
+
+		{"MissingVersion", "example.com/mod", false},
+		{"BadModulePath", "Example.com/mod v1.2.3", false},
+		{"NoDomain", "-mod v1.2.3", false},
+		{"NotSemver", "example.com/mod 1.2.3", false},
+		{"ShortVersion", "example.com/mod v1.2", false},
+		{"MajorMismatch", "example.com/mod v2.0.0", false},
+		{"ExtraField", "example.com/mod v1.2.3 // indirect", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "go_module_require")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+
+	t.Run("error message", func(t *testing.T) {
+		expectedErr := `validation failed: string example.com/mod (go_module_require=): want "<module path> <version>", got 1 fields`
+
+		err := ValidateWithTag("example.com/mod", "go_module_require")
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+}
+
+func TestLimitRangeTypeValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Pod", "Pod", true},
+		{"Container", "Container", true},
+		{"PersistentVolumeClaim", "PersistentVolumeClaim", true},
+
+		{"Lowercase", "pod", false},
+		{"Unknown", "Namespace", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_limit_range_type")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestGraphemesMaxValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		tag   string
+		valid bool
+	}{
+		{"ASCII within limit", "abc", "graphemes_max=3", true},
+		{"ASCII over limit", "abcd", "graphemes_max=3", false},
+		{"Empty", "", "graphemes_max=0", true},
+		{"Skin tone modifier", "👍🏽", "graphemes_max=1", true},
+		{"ZWJ family", "👨‍👩‍👧‍👦", "graphemes_max=1", true},
+		{"Flag", "🇩🇪", "graphemes_max=1", true},
+		{"Combining mark", "é", "graphemes_max=1", true},
+		{"Emoji sequences over limit", "👍🏽👨‍👩‍👧‍👦", "graphemes_max=1", false},
+		{"Mixed within limit", "hi 👋🏾", "graphemes_max=4", true},
+		{"Mixed over limit", "hi 👋🏾!", "graphemes_max=4", false},
+		{"Missing parameter", "a", "graphemes_max", false},
+		{"Negative parameter", "", "graphemes_max=-1", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, tt.tag)
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+
+	t.Run("distinct from max", func(t *testing.T) {
+		family := "👨‍👩‍👧‍👦"
+		require.Error(t, ValidateWithTag(family, "max=1"))
+		require.NoError(t, ValidateWithTag(family, "graphemes_max=1"))
+	})
+}
+
+func TestAppArmorProfileValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Runtime default", "runtime/default", true},
+		{"Unconfined", "unconfined", true},
+		{"Localhost", "localhost/k8s-apparmor-example-deny-write", true},
+		{"Localhost path", "localhost/usr.sbin.nginx", true},
+
+		{"Empty localhost profile", "localhost/", false},
+		{"Localhost without slash", "localhost", false},
+		{"Whitespace in profile", "localhost/my profile", false},
+		{"Unknown prefix", "docker/default", false},
+		{"Uppercase", "Unconfined", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_apparmor_profile")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestIDRangeValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input any
+		tag   string
+		valid bool
+	}{
+		{"Default root", 0, "k8s_id_range", true},
+		{"Default max", int64(math.MaxInt32), "k8s_id_range", true},
+		{"Default unsigned", uint32(1000), "k8s_id_range", true},
+		{"Default negative", -1, "k8s_id_range", false},
+		{"Default too large", int64(math.MaxInt32) + 1, "k8s_id_range", false},
+
+		{"Custom lower bound", 1000, "k8s_id_range=1000:2000", true},
+		{"Custom upper bound", int64(2000), "k8s_id_range=1000:2000", true},
+		{"Custom below", 999, "k8s_id_range=1000:2000", false},
+		{"Custom above", uint(2001), "k8s_id_range=1000:2000", false},
+		{"Custom root", 0, "k8s_id_range=1000:2000", false},
+		{"Negative allowed", -1, "k8s_id_range=-1:65535", true},
+		{"Negative below allowed", -2, "k8s_id_range=-1:65535", false},
+
+		{"Not an integer", "1000", "k8s_id_range", false},
+		{"Missing max", 1000, "k8s_id_range=1000", false},
+		{"Malformed bound", 1000, "k8s_id_range=a:2000", false},
+		{"Inverted range", 1500, "k8s_id_range=2000:1000", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, tt.tag)
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestExternalNameValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Domain", "db.example.com", true},
+		{"Cluster service", "my-db.prod.svc.cluster.local", true},
+		{"Single label", "database", true},
+
+		{"IPv4", "10.0.0.1", false},
+		{"IPv6", "fd00::1", false},
+		{"Uppercase", "DB.example.com", false},
+		{"Trailing dot", "db.example.com.", false},
+		{"Underscore", "db_1.example.com", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_external_name")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestSeccompLocalhostPathValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"File", "audit.json", true},
+		{"Nested", "profiles/audit.json", true},
+		{"Dots in name", "my..profile.json", true},
+
+		{"Absolute", "/abs/path", false},
+		{"Escape", "../escape", false},
+		{"Parent only", "..", false},
+		{"Inner parent", "profiles/../audit.json", false},
+		{"Current dir prefix", "./audit.json", false},
+		{"Double slash", "profiles//audit.json", false},
+		{"Trailing slash", "profiles/", false},
+		{"Dot", ".", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_seccomp_localhost_path")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestNodeConditionTypeValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Ready", "Ready", true},
+		{"MemoryPressure", "MemoryPressure", true},
+		{"DiskPressure", "DiskPressure", true},
+		{"PIDPressure", "PIDPressure", true},
+		{"NetworkUnavailable", "NetworkUnavailable", true},
+
+		{"Lowercase", "ready", false},
+		{"Unknown", "CustomCondition", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_node_condition_type")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestConditionStatusValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"True", "True", true},
+		{"False", "False", true},
+		{"Unknown", "Unknown", true},
+
+		{"Lowercase", "true", false},
+		{"Unknown", "Maybe", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "k8s_condition_status")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestDurationWholeSecondsValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"Seconds", "2s", true},
+		{"Minutes and seconds", "1m30s", true},
+		{"Whole milliseconds", "2000ms", true},
+		{"Zero", "0s", true},
+		{"Negative", "-5s", true},
+
+		{"Sub-second milliseconds", "1500ms", false},
+		{"Fractional seconds", "2.5s", false},
+		{"Nanoseconds", "1s1ns", false},
+		{"Missing unit", "30", false},
+		{"Free-form", "30 seconds", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "duration_whole_seconds")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
+
+func TestGatewayListenerProtocolValidator(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{"HTTP", "HTTP", true},
+		{"HTTPS", "HTTPS", true},
+		{"TLS", "TLS", true},
+		{"TCP", "TCP", true},
+		{"UDP", "UDP", true},
+
+		{"Lowercase", "https", false},
+		{"Unknown", "GRPC", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWithTag(tt.input, "gateway_listener_protocol")
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.Error(t, err, tt.name)
+		}
+	}
+}
@@ -0,0 +1,1416 @@
+package val
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// structRule is a named struct-level validation function.
+type structRule struct {
+	name string
+	fn   validator.StructLevelFunc
+}
+
+// QoSOption configures the rule registered by RegisterQoSValidation.
+type QoSOption func(*qosOptions)
+
+// qosOptions holds the settings of a rule registered by RegisterQoSValidation.
+type qosOptions struct {
+	requireLimits bool
+}
+
+// WithRequiredLimits makes RegisterQoSValidation also require a limit for every resource that
+// has a request, as for the Guaranteed QoS class.
+func WithRequiredLimits() QoSOption {
+	return func(opts *qosOptions) {
+		opts.requireLimits = true
+	}
+}
+
+// RegisterQoSValidation registers a struct-level rule for t's type that enforces resource
+// requests whenever limits are set, avoiding surprising Kubernetes QoS classes.
+//
+// The type of t must be a struct (or a pointer to a struct) declaring map fields named
+// `Limits` and `Requests` with the same key type, such as corev1.ResourceRequirements. Every resource key present
+// in `Limits` must also be present in `Requests`. Violations are reported on the `Requests`
+// field with the "qos_requests" tag and the missing resource name as the parameter.
+//
+// Requests without limits are allowed unless WithRequiredLimits is given, in which case every
+// resource key present in `Requests` must also be present in `Limits`. Those violations are
+// reported on the `Limits` field with the "qos_limits" tag.
+//
+// Example:
+//
+//	type Resources struct {
+//	    Limits   map[string]string
+//	    Requests map[string]string
+//	}
+//
+//	err := RegisterQoSValidation(Resources{}, WithRequiredLimits())
+//
+// This function is thread-safe.
+func RegisterQoSValidation(t any, opts ...QoSOption) error {
+	return defaultValidator.RegisterQoSValidation(t, opts...)
+}
+
+// RegisterQoSValidation registers a struct-level rule for t's type enforcing resource
+// requests whenever limits are set, on this instance only.
+//
+// This method is thread-safe.
+func (val *Validator) RegisterQoSValidation(t any, opts ...QoSOption) error {
+	typ, err := structType(t)
+	if err != nil {
+		return err
+	}
+	if err := requireFieldKind(typ, reflect.Map, "Limits", "Requests"); err != nil {
+		return err
+	}
+	limitsField, _ := typ.FieldByName("Limits")
+	requestsField, _ := typ.FieldByName("Requests")
+	if limitsKey, requestsKey := limitsField.Type.Key(), requestsField.Type.Key(); limitsKey != requestsKey {
+		return fmt.Errorf("%s.Limits has %s keys but %s.Requests has %s keys", typ, limitsKey, typ, requestsKey)
+	}
+
+	var options qosOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return val.registerStructRule(typ, "qos", func(sl validator.StructLevel) {
+		current := sl.Current()
+		limits := current.FieldByName("Limits")
+		requests := current.FieldByName("Requests")
+
+		reportMissingKeys(sl, limits, requests, "Requests", "qos_requests")
+		if options.requireLimits {
+			reportMissingKeys(sl, requests, limits, "Limits", "qos_limits")
+		}
+	})
+}
+
+// reportMissingKeys reports every key of the map want that's missing from the map have, found
+// in the field named field, with tag and the key as the parameter.
+func reportMissingKeys(sl validator.StructLevel, want, have reflect.Value, field, tag string) {
+	for _, key := range sortedMapKeys(want) {
+		if have.MapIndex(key).IsValid() {
+			continue
+		}
+		sl.ReportError(have.Interface(), field, field, tag, fmt.Sprint(key.Interface()))
+	}
+}
+
+// RegisterTopologySpreadValidation registers a struct-level rule for t's type validating the
+// `MaxSkew` and `TopologyKey` fields of a Kubernetes topology spread constraint together.
+//
+// The type of t must be a struct (or a pointer to a struct) declaring an integer field named
+// `MaxSkew` and a string field named `TopologyKey`. `MaxSkew` must be at least 1 (reported with
+// the "gte=1" tag) and `TopologyKey` must be a valid qualified name such as
+// "topology.kubernetes.io/zone" (reported with the "k8s_topology_key" tag).
+// Use the "k8s_when_unsatisfiable" tag to validate the `WhenUnsatisfiable` field.
+//
+// Example:
+//
+//	type TopologySpreadConstraint struct {
+//	    MaxSkew           int32
+//	    TopologyKey       string
+//	    WhenUnsatisfiable string `validate:"k8s_when_unsatisfiable"`
+//	}
+//
+//	err := RegisterTopologySpreadValidation(TopologySpreadConstraint{})
+//
+// This function is thread-safe.
+func RegisterTopologySpreadValidation(t any) error {
+	return defaultValidator.RegisterTopologySpreadValidation(t)
+}
+
+// RegisterTopologySpreadValidation registers a struct-level rule for t's type validating a
+// topology spread constraint on this instance only.
+//
+// This method is thread-safe.
+func (val *Validator) RegisterTopologySpreadValidation(t any) error {
+	typ, err := structType(t)
+	if err != nil {
+		return err
+	}
+	if err := requireIntField(typ, "MaxSkew"); err != nil {
+		return err
+	}
+	if err := requireFieldKind(typ, reflect.String, "TopologyKey"); err != nil {
+		return err
+	}
+
+	return val.registerStructRule(typ, "topology_spread", func(sl validator.StructLevel) {
+		current := sl.Current()
+
+		maxSkew := current.FieldByName("MaxSkew")
+		if maxSkew.Int() < 1 {
+			sl.ReportError(maxSkew.Interface(), "MaxSkew", "MaxSkew", "gte", "1")
+		}
+
+		topologyKey := current.FieldByName("TopologyKey")
+		if len(validation.IsQualifiedName(topologyKey.String())) != 0 {
+			sl.ReportError(topologyKey.Interface(), "TopologyKey", "TopologyKey", "k8s_topology_key", "")
+		}
+	})
+}
+
+// RegisterRequiredIfTrue registers a struct-level rule for t's type requiring each of
+// requiredFields to be set (non-zero) whenever the bool field boolField is true.
+//
+// Every missing field is reported separately with the "required_if_true" tag and boolField
+// as the parameter.
+//
+// Example:
+//
+//	type ServerConfig struct {
+//	    EnableTLS bool
+//	    CertFile  string
+//	    KeyFile   string
+//	}
+//
+//	err := RegisterRequiredIfTrue(ServerConfig{}, "EnableTLS", "CertFile", "KeyFile")
+//
+// This function is thread-safe.
+func RegisterRequiredIfTrue(t any, boolField string, requiredFields ...string) error {
+	return defaultValidator.RegisterRequiredIfTrue(t, boolField, requiredFields...)
+}
+
+// RegisterRequiredIfTrue registers a struct-level rule for t's type requiring
+// requiredFields whenever boolField is true, on this instance only.
+//
+// This method is thread-safe.
+func (val *Validator) RegisterRequiredIfTrue(t any, boolField string, requiredFields ...string) error {
+	typ, err := structType(t)
+	if err != nil {
+		return err
+	}
+	if len(requiredFields) == 0 {
+		return fmt.Errorf("at least one required field must be provided")
+	}
+	if err := requireFieldKind(typ, reflect.Bool, boolField); err != nil {
+		return err
+	}
+	if err := requireFields(typ, requiredFields...); err != nil {
+		return err
+	}
+
+	name := "required_if_true:" + boolField + ":" + strings.Join(requiredFields, ",")
+	return val.registerStructRule(typ, name, func(sl validator.StructLevel) {
+		current := sl.Current()
+		if !current.FieldByName(boolField).Bool() {
+			return
+		}
+
+		for _, fieldName := range requiredFields {
+			field := current.FieldByName(fieldName)
+			if field.IsZero() {
+				sl.ReportError(field.Interface(), fieldName, fieldName, "required_if_true", boolField)
+			}
+		}
+	})
+}
+
+// RegisterEphemeralTargetValidation registers a struct-level rule for t's type requiring the
+// container name held by targetField to reference one of the containers in containersField,
+// as expected of an ephemeral container's targetContainerName.
+//
+// containersField must be a slice of strings or of structs (or struct pointers) with a string
+// `Name` field; targetField must be a string field. An empty target is allowed. A dangling
+// target is reported with the "k8s_ephemeral_target" tag and containersField as the parameter.
+//
+// Example:
+//
+//	type PodSpec struct {
+//	    Containers          []Container
+//	    TargetContainerName string
+//	}
+//
+//	err := RegisterEphemeralTargetValidation(PodSpec{}, "Containers", "TargetContainerName")
+//
+// This function is thread-safe.
+func RegisterEphemeralTargetValidation(t any, containersField, targetField string) error {
+	return defaultValidator.RegisterEphemeralTargetValidation(t, containersField, targetField)
+}
+
+// RegisterEphemeralTargetValidation registers a struct-level rule for t's type requiring
+// targetField to name one of the containers in containersField, on this instance only.
+//
+// This method is thread-safe.
+func (val *Validator) RegisterEphemeralTargetValidation(t any, containersField, targetField string) error {
+	typ, err := structType(t)
+	if err != nil {
+		return err
+	}
+	if err := requireNamedList(typ, containersField); err != nil {
+		return err
+	}
+	if err := requireFieldKind(typ, reflect.String, targetField); err != nil {
+		return err
+	}
+
+	name := "ephemeral_target:" + containersField + ":" + targetField
+	return val.registerStructRule(typ, name, func(sl validator.StructLevel) {
+		current := sl.Current()
+
+		target := current.FieldByName(targetField)
+		if target.String() == "" {
+			return
+		}
+		if _, ok := listNames(current.FieldByName(containersField))[target.String()]; !ok {
+			sl.ReportError(target.Interface(), targetField, targetField, "k8s_ephemeral_target", containersField)
+		}
+	})
+}
+
+// RegisterWebhookClientConfigValidation registers a struct-level rule for t's type validating an
+// admission webhook clientConfig: exactly one of `URL` and `Service` must be set.
+//
+// The type of t must be a struct (or a pointer to a struct) declaring a `URL` field of type
+// string or *string and a `Service` field of any type; a field is set when it isn't the zero value.
+// Setting neither is reported on `URL` with the "required_without=Service" tag, setting both with
+// "excluded_with=Service". A set URL must use the https scheme, name a host and carry no user
+// info, query or fragment; violations are reported with the "k8s_webhook_url" tag.
+//
+// Example:
+//
+//	type WebhookClientConfig struct {
+//	    URL      *string
+//	    Service  *ServiceReference
+//	    CABundle []byte
+//	}
+//
+//	err := RegisterWebhookClientConfigValidation(WebhookClientConfig{})
+//
+// This function is thread-safe.
+func RegisterWebhookClientConfigValidation(t any) error {
+	return defaultValidator.RegisterWebhookClientConfigValidation(t)
+}
+
+// RegisterWebhookClientConfigValidation registers a struct-level rule for t's type validating
+// a webhook client config on this instance only.
+//
+// This method is thread-safe.
+func (val *Validator) RegisterWebhookClientConfigValidation(t any) error {
+	typ, err := structType(t)
+	if err != nil {
+		return err
+	}
+	if err := requireFields(typ, "URL", "Service"); err != nil {
+		return err
+	}
+	urlField, _ := typ.FieldByName("URL")
+	urlType := urlField.Type
+	if urlType.Kind() == reflect.Ptr {
+		urlType = urlType.Elem()
+	}
+	if urlType.Kind() != reflect.String {
+		return fmt.Errorf("%s.URL must be string or *string", typ)
+	}
+
+	return val.registerStructRule(typ, "webhook_client_config", webhookClientConfigRule)
+}
+
+// RegisterExactlyOneNonNil registers a struct-level rule for t's type requiring exactly one of
+// the named fields to be non-nil, as in a Kubernetes VolumeSource.
+//
+// Every field must be a pointer, interface, slice or map. When none is set, the first field is
+// reported; when several are set, every set field after the first is reported. Both cases use the
+// "exactly_one" tag with the space-separated field names as the parameter.
+//
+// Example:
+//
+//	type VolumeSource struct {
+//	    ConfigMap *ConfigMapVolumeSource
+//	    Secret    *SecretVolumeSource
+//	    EmptyDir  *EmptyDirVolumeSource
+//	}
+//
+//	err := RegisterExactlyOneNonNil(VolumeSource{}, "ConfigMap", "Secret", "EmptyDir")
+//
+// This function is thread-safe.
+func RegisterExactlyOneNonNil(t any, fields ...string) error {
+	return defaultValidator.RegisterExactlyOneNonNil(t, fields...)
+}
+
+// RegisterExactlyOneNonNil registers a struct-level rule for t's type requiring exactly one of
+// fields to be non-nil, on this instance only.
+//
+// This method is thread-safe.
+func (val *Validator) RegisterExactlyOneNonNil(t any, fields ...string) error {
+	typ, err := structType(t)
+	if err != nil {
+		return err
+	}
+	if err := requireNillableFields(typ, fields...); err != nil {
+		return err
+	}
+
+	param := strings.Join(fields, " ")
+	return val.registerStructRule(typ, "exactly_one:"+param, exactlyOneNonNil(fields))
+}
+
+// RegisterControllerOwnerValidation registers a struct-level rule for t's type allowing at most
+// one of the owner references in ownersField to be a controller.
+//
+// ownersField must be a slice of structs (or struct pointers) with a `Controller` field of type
+// bool or *bool, such as []metav1.OwnerReference. Every controller reference after the first is
+// reported by its index, e.g. "ObjectMeta.OwnerReferences[1]", with the "k8s_controller_owner" tag.
+//
+// Example:
+//
+//	type ObjectMeta struct {
+//	    OwnerReferences []OwnerReference
+//	}
+//
+//	err := RegisterControllerOwnerValidation(ObjectMeta{}, "OwnerReferences")
+//
+// This function is thread-safe.
+func RegisterControllerOwnerValidation(t any, ownersField string) error {
+	return defaultValidator.RegisterControllerOwnerValidation(t, ownersField)
+}
+
+// RegisterControllerOwnerValidation registers a struct-level rule for t's type allowing at
+// most one controller among the owner references in ownersField, on this instance only.
+//
+// This method is thread-safe.
+func (val *Validator) RegisterControllerOwnerValidation(t any, ownersField string) error {
+	typ, err := structType(t)
+	if err != nil {
+		return err
+	}
+	if err := requireControllerList(typ, ownersField); err != nil {
+		return err
+	}
+
+	return val.registerStructRule(typ, "controller_owner:"+ownersField, func(sl validator.StructLevel) {
+		owners := sl.Current().FieldByName(ownersField)
+
+		controllers := 0
+		for i := 0; i < owners.Len(); i++ {
+			owner := reflect.Indirect(owners.Index(i))
+			if !owner.IsValid() {
+				continue
+			}
+
+			controller := reflect.Indirect(owner.FieldByName("Controller"))
+			if !controller.IsValid() || !controller.Bool() {
+				continue
+			}
+
+			controllers++
+			if controllers > 1 {
+				name := fmt.Sprintf("%s[%d]", ownersField, i)
+				sl.ReportError(owners.Index(i).Interface(), name, name, "k8s_controller_owner", "")
+			}
+		}
+	})
+}
+
+// RegisterPDBValidation registers a struct-level rule for t's type requiring exactly one of the
+// `MinAvailable` and `MaxUnavailable` fields of a PodDisruptionBudget spec to be set.
+//
+// The type of t must be a struct (or a pointer to a struct) declaring both fields, typically as
+// *intstr.IntOrString; a field is set when it isn't the zero value. Setting neither is reported on
+// `MinAvailable` with the "required_without=MaxUnavailable" tag, setting both on `MaxUnavailable`
+// with "excluded_with=MinAvailable". The format of the values is left to field tags.
+//
+// Example:
+//
+//	type PodDisruptionBudgetSpec struct {
+//	    MinAvailable   *intstr.IntOrString
+//	    MaxUnavailable *intstr.IntOrString
+//	}
+//
+//	err := RegisterPDBValidation(PodDisruptionBudgetSpec{})
+//
+// This function is thread-safe.
+func RegisterPDBValidation(t any) error {
+	return defaultValidator.RegisterPDBValidation(t)
+}
+
+// RegisterPDBValidation registers a struct-level rule for t's type validating a
+// PodDisruptionBudget spec on this instance only.
+//
+// This method is thread-safe.
+func (val *Validator) RegisterPDBValidation(t any) error {
+	typ, err := structType(t)
+	if err != nil {
+		return err
+	}
+	if err := requireFields(typ, "MinAvailable", "MaxUnavailable"); err != nil {
+		return err
+	}
+
+	return val.registerStructRule(typ, "pdb", func(sl validator.StructLevel) {
+		current := sl.Current()
+		minAvailable := current.FieldByName("MinAvailable")
+		maxUnavailable := current.FieldByName("MaxUnavailable")
+
+		switch {
+		case minAvailable.IsZero() && maxUnavailable.IsZero():
+			sl.ReportError(minAvailable.Interface(), "MinAvailable", "MinAvailable", "required_without", "MaxUnavailable")
+		case !minAvailable.IsZero() && !maxUnavailable.IsZero():
+			sl.ReportError(maxUnavailable.Interface(), "MaxUnavailable", "MaxUnavailable", "excluded_with", "MinAvailable")
+		}
+	})
+}
+
+var (
+	// probeFieldNames lists the container fields holding probes.
+	probeFieldNames = []string{"LivenessProbe", "ReadinessProbe", "StartupProbe"}
+	// probeHandlerFieldNames lists the probe handlers that may reference a container port by name.
+	probeHandlerFieldNames = []string{"HTTPGet", "TCPSocket"}
+)
+
+// RegisterProbePortNameValidation registers a struct-level rule for t's type requiring every
+// named port referenced by a container probe to be declared by that container.
+//
+// containersField must be a slice of structs (or struct pointers) shaped like corev1.Container:
+// declared ports are read from `Ports[].Name` and probes from the `LivenessProbe`,
+// `ReadinessProbe` and `StartupProbe` fields, whose `HTTPGet` and `TCPSocket` handlers hold a
+// `Port` that is either a string or an IntOrString-like struct with a `StrVal` field.
+// Numeric ports aren't checked. A dangling name is reported on the handler's port, e.g.
+// "PodSpec.Containers[0].LivenessProbe.HTTPGet.Port", with the "k8s_probe_port" tag and the
+// port name as the parameter.
+//
+// Example:
+//
+//	type PodSpec struct {
+//	    Containers []Container
+//	}
+//
+//	err := RegisterProbePortNameValidation(PodSpec{}, "Containers")
+//
+// This function is thread-safe.
+func RegisterProbePortNameValidation(t any, containersField string) error {
+	return defaultValidator.RegisterProbePortNameValidation(t, containersField)
+}
+
+// RegisterProbePortNameValidation registers a struct-level rule for t's type requiring the
+// named probe ports of the containers in containersField to be declared, on this instance only.
+//
+// This method is thread-safe.
+func (val *Validator) RegisterProbePortNameValidation(t any, containersField string) error {
+	typ, err := structType(t)
+	if err != nil {
+		return err
+	}
+	if err := requireStructList(typ, containersField); err != nil {
+		return err
+	}
+
+	return val.registerStructRule(typ, "probe_port_name:"+containersField, func(sl validator.StructLevel) {
+		containers := sl.Current().FieldByName(containersField)
+		for i := 0; i < containers.Len(); i++ {
+			container := reflect.Indirect(containers.Index(i))
+			if !container.IsValid() {
+				continue
+			}
+
+			declared := declaredPortNames(container)
+			for _, probeName := range probeFieldNames {
+				for _, handlerName := range probeHandlerFieldNames {
+					port := structPath(container, probeName, handlerName, "Port")
+					name := portName(port)
+					if name == "" {
+						continue
+					}
+					if _, ok := declared[name]; !ok {
+						fieldName := fmt.Sprintf("%s[%d].%s.%s.Port", containersField, i, probeName, handlerName)
+						sl.ReportError(port.Interface(), fieldName, fieldName, "k8s_probe_port", name)
+					}
+				}
+			}
+		}
+	})
+}
+
+// RegisterPullPolicyConsistency registers a struct-level rule for t's type requiring the
+// `Always` image pull policy for images that track a moving tag.
+//
+// imageField and policyField must be string fields. When the image in imageField has no tag or
+// the `latest` tag and isn't pinned by digest, the policy in policyField must be `Always` or
+// empty (which Kubernetes defaults to `Always` for such images). Violations are reported on
+// policyField with the "k8s_latest_pull_policy" tag and `Always` as the parameter.
+//
+// Example:
+//
+//	type Container struct {
+//	    Image           string
+//	    ImagePullPolicy string
+//	}
+//
+//	err := RegisterPullPolicyConsistency(Container{}, "Image", "ImagePullPolicy")
+//
+// This function is thread-safe.
+func RegisterPullPolicyConsistency(t any, imageField, policyField string) error {
+	return defaultValidator.RegisterPullPolicyConsistency(t, imageField, policyField)
+}
+
+// RegisterPullPolicyConsistency registers a struct-level rule for t's type requiring the
+// `Always` pull policy for images tracking a moving tag, on this instance only.
+//
+// This method is thread-safe.
+func (val *Validator) RegisterPullPolicyConsistency(t any, imageField, policyField string) error {
+	typ, err := structType(t)
+	if err != nil {
+		return err
+	}
+	if err := requireFieldKind(typ, reflect.String, imageField, policyField); err != nil {
+		return err
+	}
+
+	name := "pull_policy_consistency:" + imageField + ":" + policyField
+	return val.registerStructRule(typ, name, func(sl validator.StructLevel) {
+		current := sl.Current()
+
+		_, tag, digest := parseImageReference(current.FieldByName(imageField).String())
+		if digest != "" || (tag != "" && tag != "latest") {
+			return
+		}
+
+		policy := current.FieldByName(policyField)
+		if policy.String() != "" && policy.String() != "Always" {
+			sl.ReportError(policy.Interface(), policyField, policyField, "k8s_latest_pull_policy", "Always")
+		}
+	})
+}
+
+// RegisterImageVolumeValidation registers a struct-level rule for t's type validating an image
+// volume source: `Reference` must be set to a container image and `PullPolicy` must be empty
+// (defaulted) or a valid pull policy.
+//
+// The type of t must be a struct (or a pointer to a struct) declaring string `Reference` and
+// `PullPolicy` fields, like ImageVolumeSource. A missing reference is reported with the
+// "required" tag, a malformed one with "container_image" and an unknown policy with "k8s_pull_policy".
+//
+// Example:
+//
+//	type ImageVolumeSource struct {
+//	    Reference  string
+//	    PullPolicy PullPolicy
+//	}
+//
+//	err := RegisterImageVolumeValidation(ImageVolumeSource{})
+//
+// This function is thread-safe.
+func RegisterImageVolumeValidation(t any) error {
+	return defaultValidator.RegisterImageVolumeValidation(t)
+}
+
+// RegisterImageVolumeValidation registers a struct-level rule for t's type validating an
+// image volume source on this instance only.
+//
+// This method is thread-safe.
+func (val *Validator) RegisterImageVolumeValidation(t any) error {
+	typ, err := structType(t)
+	if err != nil {
+		return err
+	}
+	if err := requireFieldKind(typ, reflect.String, "Reference", "PullPolicy"); err != nil {
+		return err
+	}
+
+	return val.registerStructRule(typ, "image_volume", func(sl validator.StructLevel) {
+		current := sl.Current()
+
+		reference := current.FieldByName("Reference")
+		switch {
+		case reference.String() == "":
+			sl.ReportError(reference.Interface(), "Reference", "Reference", "required", "")
+		case !isContainerImage(reference.String()):
+			sl.ReportError(reference.Interface(), "Reference", "Reference", "container_image", "")
+		}
+
+		policy := current.FieldByName("PullPolicy")
+		if policy.String() != "" && !slices.Contains(enumTags["k8s_pull_policy"], policy.String()) {
+			sl.ReportError(policy.Interface(), "PullPolicy", "PullPolicy", "k8s_pull_policy", "")
+		}
+	})
+}
+
+// RegisterSupplementalGroupsValidation registers a struct-level rule for t's type requiring the
+// group IDs in field to be unique and within `0..2147483647`, like a pod's `supplementalGroups`.
+//
+// field must be a slice of signed integers, typically []int64. Out-of-range GIDs are reported by
+// index, e.g. "PodSecurityContext.SupplementalGroups[1]", with the "k8s_gid" tag; every repeat of
+// a GID seen earlier in the list is reported by its index with the "unique" tag.
+//
+// Example:
+//
+//	type PodSecurityContext struct {
+//	    SupplementalGroups []int64
+//	}
+//
+//	err := RegisterSupplementalGroupsValidation(PodSecurityContext{}, "SupplementalGroups")
+//
+// This function is thread-safe.
+func RegisterSupplementalGroupsValidation(t any, field string) error {
+	return defaultValidator.RegisterSupplementalGroupsValidation(t, field)
+}
+
+// RegisterSupplementalGroupsValidation registers a struct-level rule for t's type validating
+// the group IDs in field on this instance only.
+//
+// This method is thread-safe.
+func (val *Validator) RegisterSupplementalGroupsValidation(t any, field string) error {
+	typ, err := structType(t)
+	if err != nil {
+		return err
+	}
+	if err := requireIntList(typ, field); err != nil {
+		return err
+	}
+
+	return val.registerStructRule(typ, "supplemental_groups:"+field, func(sl validator.StructLevel) {
+		groups := sl.Current().FieldByName(field)
+
+		seen := make(map[int64]struct{}, groups.Len())
+		for i := 0; i < groups.Len(); i++ {
+			gid := groups.Index(i)
+			name := fmt.Sprintf("%s[%d]", field, i)
+
+			if !inIntRange(gid, intRangeTags["k8s_gid"]) {
+				sl.ReportError(gid.Interface(), name, name, "k8s_gid", "")
+				continue
+			}
+			if _, ok := seen[gid.Int()]; ok {
+				sl.ReportError(gid.Interface(), name, name, "unique", "")
+				continue
+			}
+			seen[gid.Int()] = struct{}{}
+		}
+	})
+}
+
+// RegisterTaintValidation registers a struct-level rule for t's type validating a node taint:
+// `Key` must be a qualified name, `Value` an empty or valid label value and `Effect` one of
+// `NoSchedule`, `PreferNoSchedule` and `NoExecute`.
+//
+// The type of t must be a struct (or a pointer to a struct) declaring string `Key`, `Value` and
+// `Effect` fields, like corev1.Taint. Violations are reported with the "k8s_taint_key",
+// "k8s_label_value" and "k8s_taint_effect" tags. When the type also declares a
+// `TolerationSeconds` field, setting it is only allowed for `NoExecute` and is otherwise
+// reported with the "excluded_unless=Effect NoExecute" tag.
+//
+// Example:
+//
+//	type Taint struct {
+//	    Key    string
+//	    Value  string
+//	    Effect TaintEffect
+//	}
+//
+//	err := RegisterTaintValidation(Taint{})
+//
+// This function is thread-safe.
+func RegisterTaintValidation(t any) error {
+	return defaultValidator.RegisterTaintValidation(t)
+}
+
+// RegisterTaintValidation registers a struct-level rule for t's type validating a node taint
+// on this instance only.
+//
+// This method is thread-safe.
+func (val *Validator) RegisterTaintValidation(t any) error {
+	typ, err := structType(t)
+	if err != nil {
+		return err
+	}
+	if err := requireFieldKind(typ, reflect.String, "Key", "Value", "Effect"); err != nil {
+		return err
+	}
+	_, hasTolerationSeconds := typ.FieldByName("TolerationSeconds")
+
+	return val.registerStructRule(typ, "taint", func(sl validator.StructLevel) {
+		current := sl.Current()
+
+		key := current.FieldByName("Key")
+		if len(validation.IsQualifiedName(key.String())) != 0 {
+			sl.ReportError(key.Interface(), "Key", "Key", "k8s_taint_key", "")
+		}
+
+		value := current.FieldByName("Value")
+		if len(validation.IsValidLabelValue(value.String())) != 0 {
+			sl.ReportError(value.Interface(), "Value", "Value", "k8s_label_value", "")
+		}
+
+		effect := current.FieldByName("Effect")
+		if !slices.Contains(enumTags["k8s_taint_effect"], effect.String()) {
+			sl.ReportError(effect.Interface(), "Effect", "Effect", "k8s_taint_effect", "")
+		}
+
+		if !hasTolerationSeconds {
+			return
+		}
+		tolerationSeconds := current.FieldByName("TolerationSeconds")
+		if !tolerationSeconds.IsZero() && effect.String() != "NoExecute" {
+			sl.ReportError(tolerationSeconds.Interface(), "TolerationSeconds", "TolerationSeconds", "excluded_unless", "Effect NoExecute")
+		}
+	})
+}
+
+// RegisterObjectEnvRefValidation registers a struct-level rule for t's type validating a
+// reference to a ConfigMap or Secret, as used by envFrom sources and volumes: nameField must be
+// set to a valid object name, whether the reference is optional or not.
+//
+// The type of t must be a struct (or a pointer to a struct) declaring nameField as a string,
+// like corev1.ConfigMapEnvSource's embedded `Name`. An empty name is reported with the
+// "required" tag, an invalid one with the "k8s_object_name" tag (an RFC 1123 DNS subdomain).
+//
+// Example:
+//
+//	type SecretEnvSource struct {
+//	    Name     string
+//	    Optional *bool
+//	}
+//
+//	err := RegisterObjectEnvRefValidation(SecretEnvSource{}, "Name")
+//
+// This function is thread-safe.
+func RegisterObjectEnvRefValidation(t any, nameField string) error {
+	return defaultValidator.RegisterObjectEnvRefValidation(t, nameField)
+}
+
+// RegisterObjectEnvRefValidation registers a struct-level rule for t's type validating a
+// ConfigMap or Secret reference on this instance only.
+//
+// This method is thread-safe.
+func (val *Validator) RegisterObjectEnvRefValidation(t any, nameField string) error {
+	typ, err := structType(t)
+	if err != nil {
+		return err
+	}
+	if err := requireFieldKind(typ, reflect.String, nameField); err != nil {
+		return err
+	}
+
+	return val.registerStructRule(typ, "object_env_ref:"+nameField, func(sl validator.StructLevel) {
+		name := sl.Current().FieldByName(nameField)
+		switch {
+		case name.String() == "":
+			sl.ReportError(name.Interface(), nameField, nameField, "required", "")
+		case len(validation.IsDNS1123Subdomain(name.String())) != 0:
+			sl.ReportError(name.Interface(), nameField, nameField, "k8s_object_name", "")
+		}
+	})
+}
+
+// RegisterNodeConditionValidation registers a struct-level rule for t's type validating a node
+// condition: `Type` must be a built-in node condition type such as `Ready` or `MemoryPressure`,
+// or one of customTypes, and `Status` must be `True`, `False` or `Unknown`.
+//
+// The type of t must be a struct (or a pointer to a struct) declaring string `Type` and
+// `Status` fields, like corev1.NodeCondition. Violations are reported with the
+// "k8s_node_condition_type" and "k8s_condition_status" tags; an empty customTypes rejects every
+// condition type that isn't built in.
+//
+// Example:
+//
+//	err := RegisterNodeConditionValidation(corev1.NodeCondition{}, "KernelDeadlock", "FrequentKubeletRestart")
+//
+// This function is thread-safe.
+func RegisterNodeConditionValidation(t any, customTypes ...string) error {
+	return defaultValidator.RegisterNodeConditionValidation(t, customTypes...)
+}
+
+// RegisterNodeConditionValidation registers a struct-level rule for t's type validating a
+// node condition, allowing customTypes, on this instance only.
+//
+// This method is thread-safe.
+func (val *Validator) RegisterNodeConditionValidation(t any, customTypes ...string) error {
+	typ, err := structType(t)
+	if err != nil {
+		return err
+	}
+	if err := requireFieldKind(typ, reflect.String, "Type", "Status"); err != nil {
+		return err
+	}
+	allowedTypes := slices.Concat(enumTags["k8s_node_condition_type"], customTypes)
+
+	return val.registerStructRule(typ, "node_condition", func(sl validator.StructLevel) {
+		current := sl.Current()
+
+		conditionType := current.FieldByName("Type")
+		if !slices.Contains(allowedTypes, conditionType.String()) {
+			sl.ReportError(conditionType.Interface(), "Type", "Type", "k8s_node_condition_type", "")
+		}
+
+		status := current.FieldByName("Status")
+		if !slices.Contains(enumTags["k8s_condition_status"], status.String()) {
+			sl.ReportError(status.Interface(), "Status", "Status", "k8s_condition_status", "")
+		}
+	})
+}
+
+// RegisterSelectedInOptions registers a struct-level rule for t's type requiring selectedField
+// to be set to one of the values listed in optionsField, for self-describing configs whose
+// options enumerate the valid selections.
+//
+// selectedField must be a string field and optionsField a slice of strings. An empty selection
+// is reported with the "required" tag, a selection missing from the options with the
+// "oneof_field=<optionsField>" tag.
+//
+// Example:
+//
+//	type Choice struct {
+//	    Options  []string
+//	    Selected string
+//	}
+//
+//	err := RegisterSelectedInOptions(Choice{}, "Selected", "Options")
+//
+// This function is thread-safe.
+func RegisterSelectedInOptions(t any, selectedField, optionsField string) error {
+	return defaultValidator.RegisterSelectedInOptions(t, selectedField, optionsField)
+}
+
+// RegisterSelectedInOptions registers a struct-level rule for t's type requiring selectedField
+// to be one of the values listed in optionsField, on this instance only.
+//
+// This method is thread-safe.
+func (val *Validator) RegisterSelectedInOptions(t any, selectedField, optionsField string) error {
+	typ, err := structType(t)
+	if err != nil {
+		return err
+	}
+	if err := requireFieldKind(typ, reflect.String, selectedField); err != nil {
+		return err
+	}
+	if err := requireStringList(typ, optionsField); err != nil {
+		return err
+	}
+
+	return val.registerStructRule(typ, "selected_in_options:"+selectedField, func(sl validator.StructLevel) {
+		current := sl.Current()
+		selected := current.FieldByName(selectedField)
+		if selected.String() == "" {
+			sl.ReportError(selected.Interface(), selectedField, selectedField, "required", "")
+			return
+		}
+
+		options := current.FieldByName(optionsField)
+		for i := 0; i < options.Len(); i++ {
+			if options.Index(i).String() == selected.String() {
+				return
+			}
+		}
+		sl.ReportError(selected.Interface(), selectedField, selectedField, "oneof_field", optionsField)
+	})
+}
+
+// lifecycleHandlerFields lists the handler fields of a Kubernetes LifecycleHandler.
+var lifecycleHandlerFields = []string{"Exec", "HTTPGet", "TCPSocket", "Sleep"}
+
+// RegisterLifecycleHandlerValidation registers a struct-level rule for t's type requiring a
+// PostStart or PreStop handler to set exactly one of `Exec`, `HTTPGet`, `TCPSocket` and `Sleep`.
+//
+// The type of t must be a struct (or a pointer to a struct) declaring those four fields as
+// pointers, like corev1.LifecycleHandler. Violations are reported as by RegisterExactlyOneNonNil,
+// with the "exactly_one=Exec HTTPGet TCPSocket Sleep" tag.
+//
+// Example:
+//
+//	err := RegisterLifecycleHandlerValidation(corev1.LifecycleHandler{})
+//
+// This function is thread-safe.
+func RegisterLifecycleHandlerValidation(t any) error {
+	return defaultValidator.RegisterLifecycleHandlerValidation(t)
+}
+
+// RegisterLifecycleHandlerValidation registers a struct-level rule for t's type requiring a
+// lifecycle handler to set exactly one action, on this instance only.
+//
+// This method is thread-safe.
+func (val *Validator) RegisterLifecycleHandlerValidation(t any) error {
+	typ, err := structType(t)
+	if err != nil {
+		return err
+	}
+	if err := requireNillableFields(typ, lifecycleHandlerFields...); err != nil {
+		return err
+	}
+
+	return val.registerStructRule(typ, "lifecycle_handler", exactlyOneNonNil(lifecycleHandlerFields))
+}
+
+// RegisterStructValidation registers fn as a custom struct-level function for the struct types of
+// types, for rules that span several fields such as mutually exclusive settings.
+// Violations reported with sl.ReportError are formatted like any other field error.
+//
+// Each of types must be a struct or a pointer to a struct. The function runs alongside the rules
+// registered by the other Register* functions for the same type; registering another custom
+// function for a type replaces the previous one.
+//
+// Example:
+//
+//	err := RegisterStructValidation(func(sl validator.StructLevel) {
+//	    cfg := sl.Current().Interface().(Config)
+//	    if cfg.Token != "" && cfg.TokenFile != "" {
+//	        sl.ReportError(cfg.TokenFile, "TokenFile", "TokenFile", "excluded_with", "Token")
+//	    }
+//	}, Config{})
+//
+// This function is thread-safe.
+func RegisterStructValidation(fn validator.StructLevelFunc, types ...any) error {
+	return defaultValidator.RegisterStructValidation(fn, types...)
+}
+
+// RegisterStructValidation registers fn as a custom struct-level function for the struct types
+// of types on this instance only.
+//
+// This method is thread-safe.
+func (val *Validator) RegisterStructValidation(fn validator.StructLevelFunc, types ...any) error {
+	if fn == nil {
+		return fmt.Errorf("function cannot be empty")
+	}
+	if len(types) == 0 {
+		return fmt.Errorf("at least one type must be provided")
+	}
+
+	structTypes := make([]reflect.Type, 0, len(types))
+	for _, t := range types {
+		typ, err := structType(t)
+		if err != nil {
+			return err
+		}
+		structTypes = append(structTypes, typ)
+	}
+
+	for _, typ := range structTypes {
+		if err := val.registerStructRule(typ, "struct_validation", fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// registerStructRule adds fn as the rule called name for typ and registers a struct-level
+// function running all of typ's rules in registration order.
+// Registering a rule with a name already used for typ replaces the previous rule.
+//
+// The underlying validator caches a type's struct-level function the first time the type is
+// validated, so the registered function looks the rules up on every call: rules added later
+// still run, as long as the type had a rule before its first validation.
+func (val *Validator) registerStructRule(typ reflect.Type, name string, fn validator.StructLevelFunc) error {
+	val.mtx.Lock()
+	defer val.mtx.Unlock()
+
+	rules := val.structRules[typ]
+	replaced := false
+	for i := range rules {
+		if rules[i].name == name {
+			rules[i].fn = fn
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		rules = append(rules, structRule{name: name, fn: fn})
+	}
+	val.structRules[typ] = rules
+
+	// Validation holds the read lock while this runs, so reading structRules is safe.
+	val.validate.RegisterStructValidation(func(sl validator.StructLevel) {
+		named := namedStructLevel{StructLevel: sl, typ: typ, fieldName: val.fieldName}
+		for _, rule := range val.structRules[typ] {
+			rule.fn(named)
+		}
+	}, reflect.Zero(typ).Interface())
+
+	return nil
+}
+
+// namedStructLevel lets struct-level rules report errors by Go field paths while errors show the
+// field names of the validator's field name tag, like errors of field tags do.
+type namedStructLevel struct {
+	validator.StructLevel
+	typ       reflect.Type
+	fieldName validator.TagNameFunc
+}
+
+// ReportError reports an error on the field at the Go field path fieldName, such as
+// "Containers[0].Ports[1]", translating it to the configured field names.
+func (sl namedStructLevel) ReportError(field any, fieldName, structFieldName, tag, param string) {
+	sl.StructLevel.ReportError(field, fieldPath(sl.typ, fieldName, sl.fieldName), structFieldName, tag, param)
+}
+
+// fieldPath translates a path of Go field names below typ, such as "Containers[0].Ports[1]", to
+// the names returned by name, keeping indexes. Segments it can't resolve are kept as they are.
+func fieldPath(typ reflect.Type, path string, name validator.TagNameFunc) string {
+	segments := strings.Split(path, ".")
+	for i, segment := range segments {
+		for typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+		if typ.Kind() != reflect.Struct {
+			break
+		}
+
+		goName, _, _ := strings.Cut(segment, "[")
+		field, ok := typ.FieldByName(goName)
+		if !ok {
+			break
+		}
+		if custom := name(field); custom != "" {
+			segments[i] = custom + strings.TrimPrefix(segment, goName)
+		}
+
+		typ = elemType(field.Type, strings.Count(segment, "["))
+	}
+	return strings.Join(segments, ".")
+}
+
+// elemType returns the element type reached by indexing typ depth times, as in "Ports[1]" or
+// "Matrix[0][1]", dereferencing pointers along the way. It stops at the first type that can't
+// be indexed.
+func elemType(typ reflect.Type, depth int) reflect.Type {
+	for i := 0; i < depth; i++ {
+		for typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+		if typ.Kind() != reflect.Slice && typ.Kind() != reflect.Array && typ.Kind() != reflect.Map {
+			break
+		}
+		typ = typ.Elem()
+	}
+	return typ
+}
+
+// structType returns the struct type of t, dereferencing a pointer type.
+func structType(t any) (reflect.Type, error) {
+	if t == nil {
+		return nil, ErrNilInput
+	}
+
+	typ := reflect.TypeOf(t)
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: %s", ErrNotStruct, typ)
+	}
+
+	return typ, nil
+}
+
+// webhookClientConfigRule is the struct-level function implementing
+// RegisterWebhookClientConfigValidation.
+func webhookClientConfigRule(sl validator.StructLevel) {
+	current := sl.Current()
+	urlField := current.FieldByName("URL")
+	urlSet := !urlField.IsZero()
+	serviceSet := !current.FieldByName("Service").IsZero()
+
+	switch {
+	case !urlSet && !serviceSet:
+		sl.ReportError(urlField.Interface(), "URL", "URL", "required_without", "Service")
+	case urlSet && serviceSet:
+		sl.ReportError(urlField.Interface(), "URL", "URL", "excluded_with", "Service")
+	case urlSet && !isWebhookURL(reflect.Indirect(urlField).String()):
+		sl.ReportError(urlField.Interface(), "URL", "URL", "k8s_webhook_url", "")
+	}
+}
+
+// exactlyOneNonNil returns a struct-level function implementing RegisterExactlyOneNonNil for fields.
+func exactlyOneNonNil(fields []string) validator.StructLevelFunc {
+	param := strings.Join(fields, " ")
+
+	return func(sl validator.StructLevel) {
+		current := sl.Current()
+
+		set := 0
+		for _, name := range fields {
+			field := current.FieldByName(name)
+			if field.IsNil() {
+				continue
+			}
+			set++
+			if set > 1 {
+				sl.ReportError(field.Interface(), name, name, "exactly_one", param)
+			}
+		}
+
+		if set == 0 {
+			sl.ReportError(current.FieldByName(fields[0]).Interface(), fields[0], fields[0], "exactly_one", param)
+		}
+	}
+}
+
+// parseImageReference splits a container image reference of the form
+// "[registry[:port]/]repository[:tag][@digest]" into its name, tag and digest.
+// The name keeps the registry; tag and digest are empty when absent.
+func parseImageReference(ref string) (name, tag, digest string) {
+	name, digest, _ = strings.Cut(ref, "@")
+
+	lastSlash := strings.LastIndex(name, "/")
+	if i := strings.LastIndex(name, ":"); i > lastSlash {
+		name, tag = name[:i], name[i+1:]
+	}
+	return name, tag, digest
+}
+
+// isWebhookURL reports whether raw is a URL Kubernetes accepts for an admission webhook:
+// https, with a host and without user info, query or fragment.
+func isWebhookURL(raw string) bool {
+	if strings.ContainsAny(raw, "?#") {
+		return false
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	return u.Scheme == "https" && u.Host != "" && u.User == nil
+}
+
+// requireFields ensures that typ declares each of the named fields.
+func requireFields(typ reflect.Type, names ...string) error {
+	for _, name := range names {
+		if _, ok := typ.FieldByName(name); !ok {
+			return fmt.Errorf("%s has no field %q", typ, name)
+		}
+	}
+	return nil
+}
+
+// requireNillableFields ensures that typ declares at least two named fields and that each of them
+// is a pointer, interface, slice or map.
+func requireNillableFields(typ reflect.Type, names ...string) error {
+	if len(names) < 2 {
+		return fmt.Errorf("at least two fields must be provided")
+	}
+
+	for _, name := range names {
+		field, ok := typ.FieldByName(name)
+		if !ok {
+			return fmt.Errorf("%s has no field %q", typ, name)
+		}
+
+		switch field.Type.Kind() {
+		case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map:
+		default:
+			return fmt.Errorf("%s.%s is %s, which can't be nil", typ, name, field.Type.Kind())
+		}
+	}
+	return nil
+}
+
+// requireFieldKind ensures that typ declares each of the named fields with the given kind.
+func requireFieldKind(typ reflect.Type, kind reflect.Kind, names ...string) error {
+	for _, name := range names {
+		field, ok := typ.FieldByName(name)
+		if !ok {
+			return fmt.Errorf("%s has no field %q", typ, name)
+		}
+		if field.Type.Kind() != kind {
+			return fmt.Errorf("%s.%s is %s, not %s", typ, name, field.Type.Kind(), kind)
+		}
+	}
+	return nil
+}
+
+// requireIntField ensures that typ declares a signed integer field with the given name.
+func requireIntField(typ reflect.Type, name string) error {
+	field, ok := typ.FieldByName(name)
+	if !ok {
+		return fmt.Errorf("%s has no field %q", typ, name)
+	}
+
+	switch field.Type.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return nil
+	default:
+		return fmt.Errorf("%s.%s is %s, not a signed integer", typ, name, field.Type.Kind())
+	}
+}
+
+// requireIntList ensures that typ declares a field with the given name holding a slice of
+// signed integers.
+func requireIntList(typ reflect.Type, name string) error {
+	field, ok := typ.FieldByName(name)
+	if !ok {
+		return fmt.Errorf("%s has no field %q", typ, name)
+	}
+	if field.Type.Kind() != reflect.Slice {
+		return fmt.Errorf("%s.%s is %s, not slice", typ, name, field.Type.Kind())
+	}
+
+	switch field.Type.Elem().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return nil
+	default:
+		return fmt.Errorf("%s.%s elements must be signed integers", typ, name)
+	}
+}
+
+// requireStringList ensures that typ declares a field with the given name holding a slice of strings.
+func requireStringList(typ reflect.Type, name string) error {
+	field, ok := typ.FieldByName(name)
+	if !ok {
+		return fmt.Errorf("%s has no field %q", typ, name)
+	}
+	if field.Type.Kind() != reflect.Slice {
+		return fmt.Errorf("%s.%s is %s, not slice", typ, name, field.Type.Kind())
+	}
+	if field.Type.Elem().Kind() != reflect.String {
+		return fmt.Errorf("%s.%s elements must be strings", typ, name)
+	}
+	return nil
+}
+
+// requireNamedList ensures that typ declares a field with the given name holding a slice of
+// strings or of structs (or struct pointers) with a string `Name` field.
+func requireNamedList(typ reflect.Type, name string) error {
+	field, ok := typ.FieldByName(name)
+	if !ok {
+		return fmt.Errorf("%s has no field %q", typ, name)
+	}
+	if field.Type.Kind() != reflect.Slice {
+		return fmt.Errorf("%s.%s is %s, not slice", typ, name, field.Type.Kind())
+	}
+
+	elem := field.Type.Elem()
+	if elem.Kind() == reflect.Ptr {
+		elem = elem.Elem()
+	}
+	if elem.Kind() == reflect.String {
+		return nil
+	}
+	if elem.Kind() == reflect.Struct {
+		if nameField, ok := elem.FieldByName("Name"); ok && nameField.Type.Kind() == reflect.String {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s.%s elements must be strings or structs with a string Name field", typ, name)
+}
+
+// requireControllerList ensures that typ declares a field with the given name holding a slice
+// of structs (or struct pointers) with a `Controller` field of type bool or *bool.
+func requireControllerList(typ reflect.Type, name string) error {
+	field, ok := typ.FieldByName(name)
+	if !ok {
+		return fmt.Errorf("%s has no field %q", typ, name)
+	}
+	if field.Type.Kind() != reflect.Slice {
+		return fmt.Errorf("%s.%s is %s, not slice", typ, name, field.Type.Kind())
+	}
+
+	elem := field.Type.Elem()
+	if elem.Kind() == reflect.Ptr {
+		elem = elem.Elem()
+	}
+	if elem.Kind() == reflect.Struct {
+		if controller, ok := elem.FieldByName("Controller"); ok {
+			controllerType := controller.Type
+			if controllerType.Kind() == reflect.Ptr {
+				controllerType = controllerType.Elem()
+			}
+			if controllerType.Kind() == reflect.Bool {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("%s.%s elements must be structs with a bool or *bool Controller field", typ, name)
+}
+
+// requireStructList ensures that typ declares a field with the given name holding a slice of
+// structs or struct pointers.
+func requireStructList(typ reflect.Type, name string) error {
+	field, ok := typ.FieldByName(name)
+	if !ok {
+		return fmt.Errorf("%s has no field %q", typ, name)
+	}
+	if field.Type.Kind() != reflect.Slice {
+		return fmt.Errorf("%s.%s is %s, not slice", typ, name, field.Type.Kind())
+	}
+
+	elem := field.Type.Elem()
+	if elem.Kind() == reflect.Ptr {
+		elem = elem.Elem()
+	}
+	if elem.Kind() != reflect.Struct {
+		return fmt.Errorf("%s.%s elements must be structs", typ, name)
+	}
+	return nil
+}
+
+// structPath follows the named fields from the struct value v, dereferencing pointers on the
+// way. It returns the zero Value when a field is missing or a pointer along the path is nil.
+func structPath(v reflect.Value, names ...string) reflect.Value {
+	for _, name := range names {
+		v = reflect.Indirect(v)
+		if v.Kind() != reflect.Struct {
+			return reflect.Value{}
+		}
+		v = v.FieldByName(name)
+		if !v.IsValid() {
+			return reflect.Value{}
+		}
+	}
+	return v
+}
+
+// declaredPortNames collects the names of the ports a container declares in its `Ports` field.
+func declaredPortNames(container reflect.Value) map[string]struct{} {
+	names := make(map[string]struct{})
+
+	ports := container.FieldByName("Ports")
+	if !ports.IsValid() || ports.Kind() != reflect.Slice {
+		return names
+	}
+	for i := 0; i < ports.Len(); i++ {
+		if name := structPath(ports.Index(i), "Name"); name.IsValid() && name.Kind() == reflect.String && name.String() != "" {
+			names[name.String()] = struct{}{}
+		}
+	}
+	return names
+}
+
+// portName returns the port name held by port, which is either a string or an
+// IntOrString-like struct with a `StrVal` field. It returns "" for numeric or missing ports.
+func portName(port reflect.Value) string {
+	port = reflect.Indirect(port)
+	switch port.Kind() {
+	case reflect.String:
+		return port.String()
+	case reflect.Struct:
+		if name := port.FieldByName("StrVal"); name.IsValid() && name.Kind() == reflect.String {
+			return name.String()
+		}
+	}
+	return ""
+}
+
+// listNames collects the names held by a slice validated with requireNamedList.
+// Nil struct pointers are skipped.
+func listNames(list reflect.Value) map[string]struct{} {
+	names := make(map[string]struct{}, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		elem := reflect.Indirect(list.Index(i))
+		switch elem.Kind() {
+		case reflect.String:
+			names[elem.String()] = struct{}{}
+		case reflect.Struct:
+			names[elem.FieldByName("Name").String()] = struct{}{}
+		}
+	}
+	return names
+}
+
+// sortedMapKeys returns the keys of the map value m ordered by their string form,
+// so that reported errors are deterministic.
+func sortedMapKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+	})
+	return keys
+}
@@ -0,0 +1,123 @@
+package val
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/go-playground/locales/en"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	entranslations "github.com/go-playground/validator/v10/translations/en"
+)
+
+// defaultLocale is the locale of the English translations bundled with every Validator.
+const defaultLocale = "en"
+
+// englishTranslator returns an English translator with go-playground's default translations
+// of the built-in tags registered on v.
+func englishTranslator(v *validator.Validate) ut.Translator {
+	trans, _ := ut.New(en.New()).GetTranslator(defaultLocale)
+	_ = entranslations.RegisterDefaultTranslations(v, trans)
+	return trans
+}
+
+// RegisterTranslation registers trans as the translator rendering the messages of
+// ValidateStructTranslated for locale, replacing any translator registered for it before.
+// English ("en") is registered by default with go-playground's translations of the built-in tags.
+//
+// Messages are looked up in trans by the failed tag, with the field name as "{0}" and the tag
+// parameter as "{1}". Tags without a translation keep their default message.
+//
+// Example:
+//
+//	trans, _ := ut.New(de.New()).GetTranslator("de")
+//	_ = trans.Add("required", "{0} ist ein Pflichtfeld", false)
+//	err := val.RegisterTranslation("de", trans)
+//
+// This function is thread-safe.
+func RegisterTranslation(locale string, trans ut.Translator) error {
+	return defaultValidator.RegisterTranslation(locale, trans)
+}
+
+// RegisterTranslation registers trans as the translator for locale on this instance.
+//
+// This method is thread-safe.
+func (val *Validator) RegisterTranslation(locale string, trans ut.Translator) error {
+	if locale == "" {
+		return fmt.Errorf("locale cannot be empty")
+	}
+	if trans == nil {
+		return fmt.Errorf("translator cannot be nil")
+	}
+
+	val.mtx.Lock()
+	defer val.mtx.Unlock()
+
+	val.translators[locale] = trans
+	return nil
+}
+
+// ValidateStructTranslated validates a struct like ValidateStruct, rendering each failed rule
+// with the translator registered for locale, e.g. "Field1 is a required field" for "en".
+// Rules without a translation, and every rule when no translator is registered for locale,
+// keep their default message.
+//
+// Example:
+//
+//	err := ValidateStructTranslated(obj, r.Header.Get("Accept-Language"))
+//
+// This function is thread-safe.
+func ValidateStructTranslated(s any, locale string) error {
+	return defaultValidator.ValidateStructTranslated(s, locale)
+}
+
+// ValidateStructTranslated validates a struct like ValidateStruct, rendering the failed rules
+// with the translator registered for locale on this instance.
+//
+// This method is thread-safe.
+func (val *Validator) ValidateStructTranslated(s any, locale string) error {
+	if err := validateInputStruct(s); err != nil {
+		return err
+	}
+
+	val.mtx.RLock()
+	defer val.mtx.RUnlock()
+
+	err := val.validate.Struct(s)
+	if err == nil {
+		return nil
+	}
+	err = val.withCappedElements(context.Background(), err, reflect.TypeOf(s), "")
+
+	var valErr validator.ValidationErrors
+	trans, ok := val.translators[locale]
+	if !ok || !errors.As(err, &valErr) {
+		return val.handleValidatorError(err, false)
+	}
+
+	fieldErrors := make([]FieldError, 0, len(valErr))
+	for _, fe := range valErr {
+		out := val.newFieldError(fe)
+		if msg, ok := translateFieldError(fe, trans); ok {
+			out.message = msg
+		}
+		fieldErrors = append(fieldErrors, out)
+	}
+	return ValidationError{Errors: fieldErrors}
+}
+
+// translateFieldError renders fe with trans, using the translation function registered on the
+// validator for the tag if any, such as the bundled English ones, or else the translator's own
+// message for the tag. It returns false when neither exists.
+func translateFieldError(fe validator.FieldError, trans ut.Translator) (string, bool) {
+	// Translate falls back to the untranslated go-playground message when no function is registered.
+	if msg := fe.Translate(trans); msg != fe.Error() {
+		return msg, true
+	}
+	if msg, err := trans.T(fe.ActualTag(), fe.Field(), fe.Param()); err == nil {
+		return msg, true
+	}
+	return "", false
+}
@@ -0,0 +1,75 @@
+package val
+
+import (
+	"testing"
+
+	"github.com/go-playground/locales/de"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type translatedInput struct {
+	Name  string `json:"name" validate:"required"`
+	Level string `json:"level" validate:"oneof=debug info"`
+	Image string `json:"image" validate:"container_image"`
+}
+
+func germanTranslator(t *testing.T) ut.Translator {
+	t.Helper()
+
+	trans, _ := ut.New(de.New()).GetTranslator("de")
+	require.NoError(t, trans.Add("required", "{0} ist ein Pflichtfeld", false))
+	require.NoError(t, trans.Add("oneof", "{0} muss einer von [{1}] sein", false))
+	return trans
+}
+
+func TestValidateStructTranslated(t *testing.T) {
+	input := translatedInput{Level: "trace", Image: "Models:v1"}
+
+	t.Run("no error", func(t *testing.T) {
+		require.NoError(t, ValidateStructTranslated(translatedInput{Name: "api", Level: "info", Image: "nginx"}, "en"))
+	})
+
+	t.Run("bundled english", func(t *testing.T) {
+		expectedErr := "validation failed: name is a required field, level must be one of [debug info], " +
+			`translatedInput.image = "Models:v1" (container_image=)`
+
+		err := ValidateStructTranslated(input, "en")
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+
+		fieldErrs, ok := AsFieldErrors(err)
+		require.True(t, ok)
+		assert.Equal(t, "translatedInput.name", fieldErrs[0].Field)
+		assert.Equal(t, "required", fieldErrs[0].Tag)
+	})
+
+	t.Run("registered translator", func(t *testing.T) {
+		v := NewValidator()
+		require.NoError(t, v.RegisterTranslation("de", germanTranslator(t)))
+		expectedErr := "validation failed: name ist ein Pflichtfeld, level muss einer von [debug info] sein, " +
+			`translatedInput.image = "Models:v1" (container_image=)`
+
+		err := v.ValidateStructTranslated(input, "de")
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+
+	t.Run("unknown locale", func(t *testing.T) {
+		err := ValidateStructTranslated(input, "de")
+		require.Error(t, err)
+		assert.Equal(t, ValidateStruct(input).Error(), err.Error())
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		require.ErrorIs(t, ValidateStructTranslated(nil, "en"), ErrNilInput)
+	})
+}
+
+func TestRegisterTranslation(t *testing.T) {
+	v := NewValidator()
+
+	require.EqualError(t, v.RegisterTranslation("", germanTranslator(t)), "locale cannot be empty")
+	require.EqualError(t, v.RegisterTranslation("de", nil), "translator cannot be nil")
+}
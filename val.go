@@ -1,24 +1,241 @@
 // Package val provides a thread-safe validation mechanism using the go-playground/validator/v10 library as a singleton.
 // Validator initialized internally and ready to use without any preparation steps.
+// Isolated instances with their own custom tags can be created with NewValidator.
 package val
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"maps"
 	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
+	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
 )
 
+// Validator is an isolated validator instance with its own custom tags, patterns, enums and struct-level rules.
+// Registering a tag on one Validator never affects another one or the package-level functions.
+type Validator struct {
+	mtx      sync.RWMutex
+	validate *validator.Validate
+
+	// tagName is the struct tag validation rules are read from.
+	tagName string
+	// fieldName returns the name errors use for a struct field, or "" for its Go name.
+	fieldName validator.TagNameFunc
+	// structRules holds the struct-level rules registered per struct type.
+	// The underlying validator keeps a single struct-level function per type, so every rule
+	// registered for a type is collected here and run from one combined function.
+	structRules map[reflect.Type][]structRule
+	// enums holds the named enums registered with RegisterEnum, referenced by "subset_of".
+	enums map[string]map[string]struct{}
+	// translators holds the translators registered per locale for ValidateStructTranslated.
+	translators map[string]ut.Translator
+}
+
+// Option configures a Validator created by NewValidator.
+type Option func(*Validator)
+
+// WithTagName sets the struct tag holding validation rules, "validate" by default.
+func WithTagName(name string) Option {
+	return func(val *Validator) {
+		val.tagName = name
+		val.validate.SetTagName(name)
+	}
+}
+
+// WithFieldNameTag sets the struct tag that field names in errors are read from: "json" by
+// default, "yaml", or "" to report Go field names. Tag options such as ",omitempty" are ignored,
+// and fields without the tag or tagged "-" are reported by their Go name.
+func WithFieldNameTag(tag string) Option {
+	return func(val *Validator) {
+		val.fieldName = tagFieldName(tag)
+		val.validate.RegisterTagNameFunc(val.fieldName)
+	}
+}
+
+// WithClock sets the function returning the current time for time-dependent rules such as
+// "x509_valid", time.Now by default. It's mainly useful to test certificate expiry.
+func WithClock(now func() time.Time) Option {
+	return func(val *Validator) {
+		x509ValidValidator(val.validate, now)
+	}
+}
+
+// defaultValidator is the instance used by the package-level functions.
+var defaultValidator *Validator
+
+// Errors returned for input that can't be validated as a struct. They're never reported as
+// validation errors by IsValidationError; use errors.Is to tell them apart.
 var (
-	mtx sync.Mutex
-	v   *validator.Validate
+	// ErrNilInput is returned when the input is nil.
+	ErrNilInput = errors.New("input is nil")
+	// ErrNilPointer is returned when the input is a nil pointer.
+	ErrNilPointer = errors.New("input is a nil pointer")
+	// ErrNotStruct is returned when the input is neither a struct nor a pointer to one.
+	ErrNotStruct = errors.New("input is not a struct")
 )
 
+var (
+	errorFormatterMtx sync.RWMutex
+	errorFormatter    = defaultErrorFormatter
+)
+
+// ValidationError is returned when validation rules fail, as opposed to invalid input or
+// unexpected validator errors. It lists every failed rule for callers building structured
+// responses, while Error renders them as a single message (see SetErrorFormatter).
+//
+// Example:
+//
+//	var valErr val.ValidationError
+//	if errors.As(err, &valErr) {
+//	    for _, fe := range valErr.Errors {
+//	        fmt.Println(fe.Field, fe.Tag, fe.Param)
+//	    }
+//	}
+type ValidationError struct {
+	Errors []FieldError
+}
+
+// Error renders the failed rules with the formatter set by SetErrorFormatter, by default as
+// "validation failed: " followed by their comma-separated descriptions.
+func (e ValidationError) Error() string {
+	errorFormatterMtx.RLock()
+	format := errorFormatter
+	errorFormatterMtx.RUnlock()
+
+	return format(e.Errors)
+}
+
+// SetErrorFormatter replaces the function rendering the message of every ValidationError,
+// e.g. to print one failed rule per line in a CLI. The formatter receives the structured
+// field errors; FieldError.Error returns the default description of each. A nil formatter
+// restores the default "validation failed: ..." rendering.
+//
+// Example:
+//
+//	val.SetErrorFormatter(func(fieldErrs []val.FieldError) string {
+//	    var b strings.Builder
+//	    for _, fe := range fieldErrs {
+//	        fmt.Fprintf(&b, "\n  • %s", fe.Error())
+//	    }
+//	    return "validation failed:" + b.String()
+//	})
+//
+// This function is thread-safe.
+func SetErrorFormatter(format func([]FieldError) string) {
+	if format == nil {
+		format = defaultErrorFormatter
+	}
+
+	errorFormatterMtx.Lock()
+	defer errorFormatterMtx.Unlock()
+	errorFormatter = format
+}
+
+// defaultErrorFormatter renders failed rules as "validation failed: " followed by their descriptions.
+func defaultErrorFormatter(fieldErrs []FieldError) string {
+	messages := make([]string, 0, len(fieldErrs))
+	for _, fe := range fieldErrs {
+		messages = append(messages, fe.Error())
+	}
+	return "validation failed: " + strings.Join(messages, ", ")
+}
+
+// MarshalJSON encodes the failed rules as {"errors":[...]}, each as encoded by FieldError.MarshalJSON.
+func (e ValidationError) MarshalJSON() ([]byte, error) {
+	fieldErrors := e.Errors
+	if fieldErrors == nil {
+		fieldErrors = []FieldError{}
+	}
+	return json.Marshal(struct {
+		Errors []FieldError `json:"errors"`
+	}{fieldErrors})
+}
+
+// FieldError describes a single failed validation rule.
+type FieldError struct {
+	// Field is the namespace of the failed struct field, such as "Config.listenAddr" or
+	// "Pod.containers[1]", or the path of the failed value for ValidateMap, such as "spec.level".
+	// It is empty for variables validated with a tag.
+	Field string
+	// Tag is the failed validation tag, such as "required" or "gt".
+	Tag string
+	// Param is the tag's parameter, such as "1024" for "gt=1024", or empty.
+	Param string
+	// Kind is the kind of the offending value, such as reflect.String.
+	Kind reflect.Kind
+	// Value is the offending value.
+	Value any
+
+	message string
+	// mapPath marks a Field reported by ValidateMap, which has no struct name to strip in JSON.
+	mapPath bool
+}
+
+// Error describes the failed rule as it appears in ValidationError's message.
+func (fe FieldError) Error() string {
+	return fe.message
+}
+
+// MarshalJSON encodes the failed rule as {"field":...,"tag":...,"param":...}. The field is the
+// path below the validated struct, e.g. "spec.replicas", named after the configured field name tag.
+// Errors of variables validated with a tag have no field and carry the offending "value" instead.
+func (fe FieldError) MarshalJSON() ([]byte, error) {
+	out := struct {
+		Field string `json:"field,omitempty"`
+		Tag   string `json:"tag"`
+		Param string `json:"param"`
+		Value any    `json:"value,omitempty"`
+	}{Tag: fe.Tag, Param: fe.Param}
+
+	switch {
+	case fe.mapPath:
+		out.Field = fe.Field
+	case fe.Field != "":
+		_, out.Field, _ = strings.Cut(fe.Field, ".")
+	default:
+		out.Value = fe.Value
+	}
+	return json.Marshal(out)
+}
+
 func init() {
-	v = newValidator()
+	defaultValidator = NewValidator()
+}
+
+// NewValidator creates a Validator with every custom validation rule of this package registered.
+// Unlike the package-level functions, it doesn't share state with any other instance, so
+// subsystems can register their own tags without clobbering each other.
+//
+// Example usage:
+//
+//	scoped := val.NewValidator(val.WithTagName("check"))
+//	err := scoped.RegisterValidation("is-even", isEven)
+//	err = scoped.ValidateStruct(obj)
+func NewValidator(opts ...Option) *Validator {
+	val := &Validator{
+		validate:    newValidator(),
+		tagName:     defaultTagName,
+		fieldName:   tagFieldName(defaultFieldNameTag),
+		structRules: map[reflect.Type][]structRule{},
+		enums:       map[string]map[string]struct{}{},
+	}
+	subsetOfValidator(val.validate, val.enums)
+	val.translators = map[string]ut.Translator{defaultLocale: englishTranslator(val.validate)}
+	for _, opt := range opts {
+		opt(val)
+	}
+	return val
 }
 
 // RegisterValidation registers a custom validation function for a specific tag.
@@ -30,9 +247,223 @@ func init() {
 //
 // This function is thread-safe.
 func RegisterValidation(tag string, fn validator.Func) error {
-	mtx.Lock()
-	defer mtx.Unlock()
-	return v.RegisterValidation(tag, fn)
+	return defaultValidator.RegisterValidation(tag, fn)
+}
+
+// RegisterValidation registers a custom validation function for a specific tag on this instance only.
+//
+// This method is thread-safe.
+func (val *Validator) RegisterValidation(tag string, fn validator.Func) error {
+	val.mtx.Lock()
+	defer val.mtx.Unlock()
+	return val.validate.RegisterValidation(tag, fn)
+}
+
+// RegisterValidationCtx registers a context-aware custom validation function for a specific tag.
+// The function receives the context passed to ValidateStructCtx or ValidateWithTagCtx.
+// Example usage:
+//
+//	err := RegisterValidationCtx("allowed_namespace", func(ctx context.Context, fl validator.FieldLevel) bool {
+//	    allowed, _ := ctx.Value(allowedNamespacesKey).(map[string]bool)
+//	    return allowed[fl.Field().String()]
+//	})
+//
+// This function is thread-safe.
+func RegisterValidationCtx(tag string, fn validator.FuncCtx) error {
+	return defaultValidator.RegisterValidationCtx(tag, fn)
+}
+
+// RegisterValidationCtx registers a context-aware custom validation function for a specific tag
+// on this instance only.
+//
+// This method is thread-safe.
+func (val *Validator) RegisterValidationCtx(tag string, fn validator.FuncCtx) error {
+	val.mtx.Lock()
+	defer val.mtx.Unlock()
+	return val.validate.RegisterValidationCtx(tag, fn)
+}
+
+// RegisterAlias registers alias as a shorthand for a comma-separated list of tags, so a rule
+// repeated across many structs is declared once.
+// Aliases must be registered before the structs using them are validated for the first time,
+// because the underlying validator caches each struct's parsed tags.
+//
+// Example usage:
+//
+//	err := RegisterAlias("service_port", "required,gt=1024,lt=65536")
+//
+//	type Config struct {
+//	    Port int `validate:"service_port"`
+//	}
+//
+// This function is thread-safe.
+func RegisterAlias(alias, tags string) error {
+	return defaultValidator.RegisterAlias(alias, tags)
+}
+
+// RegisterAlias registers alias as a shorthand for a comma-separated list of tags on this instance only.
+//
+// This method is thread-safe.
+func (val *Validator) RegisterAlias(alias, tags string) (err error) {
+	if alias == "" {
+		return fmt.Errorf("alias cannot be empty")
+	}
+	if tags == "" {
+		return fmt.Errorf("alias %q must have at least one tag", alias)
+	}
+
+	val.mtx.Lock()
+	defer val.mtx.Unlock()
+
+	// The underlying validator panics on reserved aliases such as "dive" or "omitempty".
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	val.validate.RegisterAlias(alias, tags)
+	return nil
+}
+
+// RegisterCustomTypeFunc registers fn to extract the value validated for fields of the types of
+// types, such as the string of a sql.NullString, so tags like "required" apply to that value
+// instead of the wrapper type.
+//
+// Example usage:
+//
+//	err := RegisterCustomTypeFunc(func(field reflect.Value) any {
+//	    if ns, ok := field.Interface().(sql.NullString); ok && ns.Valid {
+//	        return ns.String
+//	    }
+//	    return nil
+//	}, sql.NullString{})
+//
+// This function is thread-safe.
+func RegisterCustomTypeFunc(fn validator.CustomTypeFunc, types ...any) error {
+	return defaultValidator.RegisterCustomTypeFunc(fn, types...)
+}
+
+// RegisterCustomTypeFunc registers fn to extract the value validated for fields of the types
+// of types on this instance only.
+//
+// This method is thread-safe.
+func (val *Validator) RegisterCustomTypeFunc(fn validator.CustomTypeFunc, types ...any) error {
+	if fn == nil {
+		return fmt.Errorf("function cannot be empty")
+	}
+	if len(types) == 0 {
+		return fmt.Errorf("at least one type must be provided")
+	}
+	if slices.Contains(types, nil) {
+		return ErrNilInput
+	}
+
+	val.mtx.Lock()
+	defer val.mtx.Unlock()
+	val.validate.RegisterCustomTypeFunc(fn, types...)
+	return nil
+}
+
+// RegisterPattern registers a custom validation tag matching string values against a regular expression.
+// The pattern is compiled once at registration time and kept by the validator the tag is
+// registered on, so patterns registered on different validator instances never leak into each other.
+//
+// Example usage:
+//
+//	err := RegisterPattern("ticket_id", `^[A-Z]+-[0-9]+$`)
+//
+// This function is thread-safe.
+func RegisterPattern(tag, pattern string) error {
+	return defaultValidator.RegisterPattern(tag, pattern)
+}
+
+// RegisterPattern registers a custom validation tag matching string values against a regular
+// expression on this instance only.
+//
+// This method is thread-safe.
+func (val *Validator) RegisterPattern(tag, pattern string) error {
+	val.mtx.Lock()
+	defer val.mtx.Unlock()
+	return registerPattern(val.validate, tag, pattern)
+}
+
+// SetTagName sets the struct tag the package-level functions read validation rules from,
+// "validate" by default, e.g. to reuse existing `binding:"..."` tags of another framework.
+//
+// It must be called before any validation: struct tags are parsed once per type and cached,
+// so types validated before keep the rules of the previous tag.
+//
+// This function is thread-safe.
+func SetTagName(name string) {
+	defaultValidator.SetTagName(name)
+}
+
+// SetTagName sets the struct tag this instance reads validation rules from. Like the
+// package-level SetTagName, it must be called before any validation.
+//
+// This method is thread-safe.
+func (val *Validator) SetTagName(name string) {
+	val.mtx.Lock()
+	defer val.mtx.Unlock()
+	val.tagName = name
+	val.validate.SetTagName(name)
+}
+
+// Instance returns the go-playground validator behind the package-level functions, as an
+// escape hatch for features this package doesn't wrap, such as RegisterStructValidationMapRules
+// or RegisterTranslation with custom translation functions.
+//
+// This is an advanced API: calls on the returned validator aren't guarded by the package
+// mutex, so configure it during initialization, before any validation runs concurrently.
+// Changing its tag name function also bypasses the field names used by struct-level rules.
+func Instance() *validator.Validate {
+	return defaultValidator.Instance()
+}
+
+// Instance returns the go-playground validator behind this instance. Like the package-level
+// Instance, it's an advanced escape hatch whose calls aren't guarded by the instance's mutex.
+func (val *Validator) Instance() *validator.Validate {
+	return val.validate
+}
+
+// RegisterEnum registers a named set of allowed string values.
+// Named enums are referenced by parameterized tags such as "subset_of=<name>".
+// Registering an existing name replaces its values.
+//
+// Example usage:
+//
+//	err := RegisterEnum("features", "metrics", "tracing", "profiling")
+//
+//	type Config struct {
+//	    Features []string `validate:"subset_of=features"`
+//	}
+//
+// This function is thread-safe.
+func RegisterEnum(name string, values ...string) error {
+	return defaultValidator.RegisterEnum(name, values...)
+}
+
+// RegisterEnum registers a named set of allowed string values, referenced by "subset_of=<name>"
+// in the tags validated by this instance only.
+//
+// This method is thread-safe.
+func (val *Validator) RegisterEnum(name string, values ...string) error {
+	if name == "" {
+		return fmt.Errorf("enum name cannot be empty")
+	}
+	if len(values) == 0 {
+		return fmt.Errorf("enum %q must have at least one value", name)
+	}
+
+	allowed := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		allowed[value] = struct{}{}
+	}
+
+	val.mtx.Lock()
+	defer val.mtx.Unlock()
+	val.enums[name] = allowed
+	return nil
 }
 
 // ValidateWithTag validates a single variable using a specified validation tag.
@@ -49,8 +480,39 @@ func RegisterValidation(tag string, fn validator.Func) error {
 //
 // This function is thread-safe.
 func ValidateWithTag(variable any, tag string) error {
-	if err := v.Var(variable, tag); err != nil {
-		return handleValidatorError(err)
+	return defaultValidator.ValidateWithTag(variable, tag)
+}
+
+// ValidateWithTag validates a single variable using a specified validation tag and the tags
+// registered on this instance.
+//
+// This method is thread-safe.
+func (val *Validator) ValidateWithTag(variable any, tag string) error {
+	return val.ValidateWithTagCtx(context.Background(), variable, tag)
+}
+
+// ValidateWithTagCtx validates a single variable like ValidateWithTag, passing ctx to
+// context-aware validation functions so they can look up request-scoped values.
+//
+// Example:
+//
+//	err := ValidateWithTagCtx(r.Context(), namespace, "allowed_namespace")
+//
+// This function is thread-safe.
+func ValidateWithTagCtx(ctx context.Context, variable any, tag string) error {
+	return defaultValidator.ValidateWithTagCtx(ctx, variable, tag)
+}
+
+// ValidateWithTagCtx validates a single variable like ValidateWithTag, passing ctx to the
+// context-aware validation functions registered on this instance.
+//
+// This method is thread-safe.
+func (val *Validator) ValidateWithTagCtx(ctx context.Context, variable any, tag string) error {
+	val.mtx.RLock()
+	defer val.mtx.RUnlock()
+
+	if err := val.validate.VarCtx(ctx, variable, tag); err != nil {
+		return val.handleValidatorError(val.withCappedElements(ctx, err, nil, tag), false)
 	}
 	return nil
 }
@@ -77,80 +539,885 @@ func ValidateWithTag(variable any, tag string) error {
 //
 // This function is thread-safe.
 func ValidateStruct(s any) error {
+	return defaultValidator.ValidateStruct(s)
+}
+
+// ValidateStruct validates a struct based on its validation tags, using the tags and
+// struct-level rules registered on this instance.
+//
+// This method is thread-safe.
+func (val *Validator) ValidateStruct(s any) error {
+	return val.ValidateStructCtx(context.Background(), s)
+}
+
+// ValidateStructT validates a struct like ValidateStruct, keeping its static type so generic
+// code can validate its type parameter without converting it to any first. T should be a struct
+// or a pointer to one: Go constraints can't express struct kinds, so other types are still
+// rejected at run time with ErrNotStruct, and a nil *T with ErrNilPointer.
+//
+// Example:
+//
+//	func decode[T any](r io.Reader) (T, error) {
+//	    var v T
+//	    if err := json.NewDecoder(r).Decode(&v); err != nil {
+//	        return v, err
+//	    }
+//	    return v, val.ValidateStructT(v)
+//	}
+//
+// This function is thread-safe.
+func ValidateStructT[T any](s T) error {
+	return defaultValidator.ValidateStruct(s)
+}
+
+// ValidateStructCtx validates a struct like ValidateStruct, passing ctx to context-aware
+// validation functions so they can read request-scoped values such as a tenant ID.
+//
+// Example:
+//
+//	ctx := context.WithValue(r.Context(), tenantKey, "acme")
+//	err := ValidateStructCtx(ctx, obj)
+//
+// This function is thread-safe.
+func ValidateStructCtx(ctx context.Context, s any) error {
+	return defaultValidator.ValidateStructCtx(ctx, s)
+}
+
+// ValidateStructCtx validates a struct like ValidateStruct, passing ctx to the context-aware
+// validation functions registered on this instance.
+//
+// This method is thread-safe.
+func (val *Validator) ValidateStructCtx(ctx context.Context, s any) error {
+	if err := validateInputStruct(s); err != nil {
+		return err
+	}
+
+	val.mtx.RLock()
+	defer val.mtx.RUnlock()
+
+	if err := val.validate.StructCtx(ctx, s); err != nil {
+		return val.handleValidatorError(val.withCappedElements(ctx, err, reflect.TypeOf(s), ""), false)
+	}
+	return nil
+}
+
+// ValidateStructFailFast validates a struct like ValidateStruct but reports only the first
+// failed rule, skipping the formatting of the others. It suits high-throughput request
+// validation where a single error is enough to reject the input.
+//
+// The returned ValidationError holds exactly one FieldError:
+//
+//	err := ValidateStructFailFast(TestStruct{Field2: "test"})
+//	// Output: "validation failed: TestStruct.Field1 = 0 (required=)"
+//
+// Rules are still evaluated for every field, since go-playground/validator doesn't stop
+// early, so only the cost of building the errors is saved.
+//
+// This function is thread-safe.
+func ValidateStructFailFast(s any) error {
+	return defaultValidator.ValidateStructFailFast(s)
+}
+
+// ValidateStructFailFast validates a struct like ValidateStruct, reporting only the first
+// failed rule.
+//
+// This method is thread-safe.
+func (val *Validator) ValidateStructFailFast(s any) error {
+	if err := validateInputStruct(s); err != nil {
+		return err
+	}
+
+	val.mtx.RLock()
+	defer val.mtx.RUnlock()
+
+	if err := val.validate.Struct(s); err != nil {
+		return val.handleValidatorError(err, true)
+	}
+	return nil
+}
+
+// ValidateStructPartial validates only the named fields of a struct, e.g. the fields of one
+// step of a multi-step form, formatting errors like ValidateStruct.
+//
+// Fields are given by their Go names relative to the struct, as go-playground/validator expects,
+// such as "Email" or "Address.City"; a nested struct name covers all its fields.
+//
+// Example:
+//
+//	err := ValidateStructPartial(signup, "Email", "Password")
+//
+// This function is thread-safe.
+func ValidateStructPartial(s any, fields ...string) error {
+	return defaultValidator.ValidateStructPartial(s, fields...)
+}
+
+// ValidateStructPartial validates only the named fields of a struct on this instance.
+//
+// This method is thread-safe.
+func (val *Validator) ValidateStructPartial(s any, fields ...string) error {
+	if err := validateInputStruct(s); err != nil {
+		return err
+	}
+
+	val.mtx.RLock()
+	defer val.mtx.RUnlock()
+
+	if err := val.validate.StructPartial(s, fields...); err != nil {
+		return val.handleValidatorError(val.withCappedElements(context.Background(), err, reflect.TypeOf(s), ""), false)
+	}
+	return nil
+}
+
+// ValidateStructExcept validates every field of a struct except the named ones, formatting
+// errors like ValidateStruct. Fields are named as for ValidateStructPartial.
+//
+// Example:
+//
+//	err := ValidateStructExcept(signup, "Address")
+//
+// This function is thread-safe.
+func ValidateStructExcept(s any, fields ...string) error {
+	return defaultValidator.ValidateStructExcept(s, fields...)
+}
+
+// ValidateStructExcept validates every field of a struct except the named ones on this instance.
+//
+// This method is thread-safe.
+func (val *Validator) ValidateStructExcept(s any, fields ...string) error {
+	if err := validateInputStruct(s); err != nil {
+		return err
+	}
+
+	val.mtx.RLock()
+	defer val.mtx.RUnlock()
+
+	if err := val.validate.StructExcept(s, fields...); err != nil {
+		return val.handleValidatorError(val.withCappedElements(context.Background(), err, reflect.TypeOf(s), ""), false)
+	}
+	return nil
+}
+
+// ValidateStructMaxDepth validates a struct like ValidateStruct after ensuring that its nesting
+// doesn't exceed maxDepth. It guards recursive types, such as filter trees, against
+// pathologically deep inputs before they're processed any further.
+//
+// The top-level struct is at depth 0 and every nested struct is one level deeper, whether it's
+// held by a field, a pointer, or a slice, array or map element. Unexported fields are skipped,
+// and structs without exported fields, such as time.Time, are leaf values that don't count.
+// Cycles are walked until they nest a struct beyond maxDepth, or once when they nest none, such as
+// a []any holding itself. The first struct found beyond maxDepth is reported by its path with the
+// "max_depth" tag:
+//
+//	type Filter struct {
+//	    Op       string
+//	    Children []Filter
+//	}
+//
+//	err := ValidateStructMaxDepth(filter, 1)
+//	// Output: "validation failed: Filter.Children[0].Children[2] (max_depth=1)"
+//
+// This function is thread-safe.
+func ValidateStructMaxDepth(s any, maxDepth int) error {
+	return defaultValidator.ValidateStructMaxDepth(s, maxDepth)
+}
+
+// ValidateStructMaxDepth validates a struct like ValidateStruct after ensuring that its nesting
+// doesn't exceed maxDepth, reporting paths with this instance's field names.
+//
+// This method is thread-safe.
+func (val *Validator) ValidateStructMaxDepth(s any, maxDepth int) error {
+	if maxDepth < 0 {
+		return fmt.Errorf("max depth must not be negative")
+	}
 	if err := validateInputStruct(s); err != nil {
 		return err
 	}
 
-	if err := v.Struct(s); err != nil {
-		return handleValidatorError(err)
+	walker := depthWalker{maxDepth: maxDepth, fieldName: val.fieldName, visited: map[visit]struct{}{}}
+	root := reflect.Indirect(reflect.ValueOf(s))
+	if path, ok := walker.exceeds(root, root.Type().Name(), 0); ok {
+		param := strconv.Itoa(maxDepth)
+		return ValidationError{Errors: []FieldError{{
+			Field:   path,
+			Tag:     "max_depth",
+			Param:   param,
+			Kind:    reflect.Struct,
+			message: fmt.Sprintf("%s (max_depth=%s)", path, param),
+		}}}
+	}
+	return val.ValidateStruct(s)
+}
+
+// ValidateStructs validates every item like ValidateStruct and returns nil when all of them are
+// valid. Otherwise the failures are joined with errors.Join, each prefixed with the index of its
+// item, e.g. "items[3]: validation failed: Record.ID (required=)", one per line.
+//
+// Invalid items such as nil don't stop the batch; they're reported by index like the others.
+// IsValidationError and AsFieldErrors see the first failing item only; the failures can be
+// iterated with errors.Join's `Unwrap() []error`.
+//
+// This function is thread-safe.
+func ValidateStructs(items []any) error {
+	return defaultValidator.ValidateStructs(items)
+}
+
+// ValidateStructs validates every item like ValidateStruct on this instance, joining the
+// failures of all items prefixed with their index.
+//
+// This method is thread-safe.
+func (val *Validator) ValidateStructs(items []any) error {
+	var errs []error
+	for i, item := range items {
+		if err := val.ValidateStruct(item); err != nil {
+			errs = append(errs, fmt.Errorf("items[%d]: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateMap validates dynamic data, such as a JSON config blob decoded into a map[string]any,
+// against rules keyed like the data. A rule is either a tag string applied to the key's value,
+// or a nested rules map applied to the key's value as an object, or to each object of a list.
+//
+// Failures are returned as a ValidationError naming each value by its dotted path, in key
+// order. A value that a nested rules map can't be applied to, including a missing one, is
+// reported with the "map" tag:
+//
+//	err := ValidateMap(data, map[string]any{
+//	    "name": "required",
+//	    "spec": map[string]any{"level": "oneof=debug info"},
+//	})
+//	// Output: "validation failed: name (required=), spec.level = \"trace\" (oneof=debug info)"
+//
+// This function is thread-safe.
+func ValidateMap(data map[string]any, rules map[string]any) error {
+	return defaultValidator.ValidateMap(data, rules)
+}
+
+// ValidateMap validates dynamic data against rules like the package-level ValidateMap, using
+// the tags registered on this instance.
+//
+// This method is thread-safe.
+func (val *Validator) ValidateMap(data map[string]any, rules map[string]any) error {
+	val.mtx.RLock()
+	defer val.mtx.RUnlock()
+
+	var fieldErrors []FieldError
+	if err := val.validateMap(data, rules, "", &fieldErrors); err != nil {
+		return err
+	}
+	if len(fieldErrors) > 0 {
+		return ValidationError{Errors: fieldErrors}
 	}
 	return nil
 }
 
+// validateMap validates data against rules, appending the failures to fieldErrors with their
+// path below prefix. It returns unexpected validator errors only.
+func (val *Validator) validateMap(data map[string]any, rules map[string]any, prefix string, fieldErrors *[]FieldError) error {
+	for _, key := range slices.Sorted(maps.Keys(rules)) {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+
+		switch rule := rules[key].(type) {
+		case string:
+			err := val.validate.Var(data[key], rule)
+			var valErr validator.ValidationErrors
+			if errors.As(err, &valErr) {
+				for _, fe := range valErr {
+					*fieldErrors = append(*fieldErrors, val.newMapFieldError(path, fe))
+				}
+			} else if err != nil {
+				return fmt.Errorf("unexpected validation error: %w", err)
+			}
+		case map[string]any:
+			if err := val.validateNestedMap(data[key], rule, path, fieldErrors); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("rule of %s must be a string or a map[string]any, got %T", path, rule)
+		}
+	}
+	return nil
+}
+
+// validateNestedMap applies nested rules to value, an object or a list of objects found at path.
+func (val *Validator) validateNestedMap(value any, rules map[string]any, path string, fieldErrors *[]FieldError) error {
+	switch value := value.(type) {
+	case map[string]any:
+		return val.validateMap(value, rules, path, fieldErrors)
+	case []map[string]any:
+		for i, obj := range value {
+			if err := val.validateMap(obj, rules, fmt.Sprintf("%s[%d]", path, i), fieldErrors); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []any:
+		for i, elem := range value {
+			if err := val.validateNestedMap(elem, rules, fmt.Sprintf("%s[%d]", path, i), fieldErrors); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		*fieldErrors = append(*fieldErrors, FieldError{
+			Field:   path,
+			Tag:     "map",
+			Kind:    reflect.ValueOf(value).Kind(),
+			Value:   value,
+			message: fmt.Sprintf("%s (map=)", path),
+			mapPath: true,
+		})
+		return nil
+	}
+}
+
+// newMapFieldError converts a go-playground error of the value found at path in a map.
+func (val *Validator) newMapFieldError(path string, fe validator.FieldError) FieldError {
+	out := val.newFieldError(fe)
+	out.Field = path
+	out.mapPath = true
+	out.message = fmt.Sprintf("%s%s (%s=%s)", path, scalarSuffix(reflect.ValueOf(fe.Value())), fe.ActualTag(), fe.Param())
+	return out
+}
+
+// ValidateJSONArrayStream validates a JSON array of objects read from r one element at a time,
+// so memory stays constant in the number of elements.
+// Each element is decoded into a T and validated with ValidateStruct; fn is called with the
+// element's index, the element and the decoding or validation error (nil when it's valid).
+// Returning false from fn stops the stream early.
+//
+// Elements that don't match T are reported to fn and skipped. The returned error is reserved
+// for streams that can't be read any further: malformed JSON, a value that isn't an array,
+// or a failing reader.
+//
+// Example:
+//
+//	err := ValidateJSONArrayStream(r, func(i int, pod Pod, err error) bool {
+//	    if err != nil {
+//	        log.Printf("pod %d: %v", i, err)
+//	    }
+//	    return true
+//	})
+//
+// This function is thread-safe.
+func ValidateJSONArrayStream[T any](r io.Reader, fn func(int, T, error) bool) error {
+	dec := json.NewDecoder(r)
+
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("read JSON array: %w", err)
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("read JSON array: unexpected %v", tok)
+	}
+
+	for i := 0; dec.More(); i++ {
+		var elem T
+		if err := dec.Decode(&elem); err != nil {
+			var typeErr *json.UnmarshalTypeError
+			if !errors.As(err, &typeErr) {
+				return fmt.Errorf("read JSON array element %d: %w", i, err)
+			}
+			if !fn(i, elem, err) {
+				return nil
+			}
+			continue
+		}
+
+		if !fn(i, elem, ValidateStruct(elem)) {
+			return nil
+		}
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return fmt.Errorf("read JSON array: %w", err)
+	}
+	return nil
+}
+
+// IsValidationError reports whether err, or any error in its chain, is a ValidationError
+// describing failed validation rules.
+// It returns false for invalid input errors (ErrNilInput, ErrNilPointer, ErrNotStruct) and for unexpected
+// validator errors, which makes it suitable for routing errors in middleware.
+//
+// Example:
+//
+//	if err := ValidateStruct(obj); val.IsValidationError(err) {
+//	    // respond with 422 Unprocessable Entity
+//	}
+func IsValidationError(err error) bool {
+	return errors.As(err, &ValidationError{})
+}
+
+// AsFieldErrors returns the failed rules of a ValidationError in err's chain, each with its
+// field namespace, tag, parameter, kind and value. It returns false for any other error,
+// including invalid input and unexpected validator errors.
+//
+// Example:
+//
+//	if fieldErrs, ok := val.AsFieldErrors(err); ok {
+//	    for _, fe := range fieldErrs {
+//	        fmt.Println(fe.Field, fe.Tag, fe.Param, fe.Kind)
+//	    }
+//	}
+func AsFieldErrors(err error) ([]FieldError, bool) {
+	var valErr ValidationError
+	if !errors.As(err, &valErr) {
+		return nil, false
+	}
+	return valErr.Errors, true
+}
+
 // newValidator initializes and configures a new instance of the go-playground validator.
 // This function is typically called during package initialization to set up the validator instance.
 func newValidator() *validator.Validate {
 	val := validator.New(validator.WithRequiredStructEnabled())
+	val.RegisterTagNameFunc(tagFieldName(defaultFieldNameTag))
 
 	urlPrefixValidator(val)
 	labelSelectorValidator(val)
 	fieldSelectorValidator(val)
+	digestValidator(val)
+	emailStrictValidator(val)
+	intRangeValidators(val)
+	idRangeValidator(val)
+	goVersionValidator(val)
+	urlPathValidator(val)
+	jwtShapeValidator(val)
+	selinuxLevelValidator(val)
+	selinuxLabelValidator(val)
+	apparmorProfileValidator(val)
+	seccompLocalhostPathValidator(val)
+	rawObjectValidator(val)
+	grpcMethodValidator(val)
+	base58Validator(val)
+	unprivilegedPortValidator(val)
+	fileModeValidator(val)
+	dnsNameValidators(val)
+	enumValidators(val)
+	labelKeysValidator(val)
+	bcp47Validator(val)
+	csvHeaderValidator(val)
+	metav1DurationValidator(val)
+	sliceMaxDiveValidator(val)
+	fieldRefPathValidator(val)
+	isoDateValidator(val)
+	containerImageValidator(val)
+	shellSafeValidator(val)
+	ipFamilyValidator(val)
+	percentStringValidator(val)
+	podCIDRValidator(val)
+	eventReasonValidator(val)
+	requiredNotBlankValidator(val)
+	pemValidator(val)
+	goModuleRequireValidator(val)
+	graphemesMaxValidator(val)
+	durationWholeSecondsValidator(val)
+	x509ValidValidator(val, time.Now)
 
 	return val
 }
 
+// defaultTagName is the struct tag validation rules are read from by default.
+const defaultTagName = "validate"
+
+// defaultFieldNameTag is the struct tag field names in errors are read from by default.
+const defaultFieldNameTag = "json"
+
+// tagFieldName returns a function naming struct fields after the given struct tag, ignoring
+// tag options. An empty name, returned for an empty tag too, stands for the Go field name.
+func tagFieldName(tag string) validator.TagNameFunc {
+	return func(field reflect.StructField) string {
+		if tag == "" {
+			return ""
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+		if name == "-" {
+			return ""
+		}
+		return name
+	}
+}
+
 // validateInputStruct ensures that the input is valid for struct-based validation.
 //
 // Validation Rules:
 //   - The input mustn't be nil.
 //   - If the input is a pointer, it mustn't be uninitialized (nil pointer).
+//   - The input, or the value it points to, must be a struct.
 func validateInputStruct(s any) error {
 	if s == nil {
-		return fmt.Errorf("input is nil")
+		return ErrNilInput
 	}
 
 	val := reflect.ValueOf(s)
 	if val.Kind() == reflect.Ptr {
 		if val.IsNil() {
-			return fmt.Errorf("input is a nil pointer")
+			return ErrNilPointer
 		}
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Struct {
+		return fmt.Errorf("%w: %s", ErrNotStruct, val.Type())
 	}
 
 	return nil
 }
 
+// depthWalker looks for structs nested deeper than maxDepth, naming fields with fieldName.
+type depthWalker struct {
+	maxDepth int
+	// fieldName returns the name paths use for a struct field, or "" for its Go name.
+	fieldName validator.TagNameFunc
+	// visited holds the pointers, slices and maps already walked, so that cycles that don't
+	// nest any struct deeper, such as a []any holding itself, are walked once.
+	visited map[visit]struct{}
+}
+
+// visit is a pointer, slice or map walked by a depthWalker with structs it holds at depth.
+type visit struct {
+	ptr   uintptr
+	typ   reflect.Type
+	len   int
+	depth int
+}
+
+// exceeds walks val, found at path, looking for a struct nested deeper than the walker's maxDepth.
+// depth is the level a struct held by val is at. It returns the path of the first such struct.
+// Structs without exported fields, such as time.Time, are leaf values and don't count as a level.
+func (w depthWalker) exceeds(val reflect.Value, path string, depth int) (string, bool) {
+	val, ok := w.enter(val, depth)
+	if !ok {
+		return "", false
+	}
+
+	switch val.Kind() {
+	case reflect.Struct:
+		if !hasExportedFields(val.Type()) {
+			return "", false
+		}
+		if depth > w.maxDepth {
+			return path, true
+		}
+		return w.structExceeds(val, path, depth)
+	case reflect.Slice, reflect.Array:
+		return w.listExceeds(val, path, depth)
+	case reflect.Map:
+		return w.mapExceeds(val, path, depth)
+	default:
+		return "", false
+	}
+}
+
+// structExceeds walks the exported fields of the struct val, found at path at depth.
+func (w depthWalker) structExceeds(val reflect.Value, path string, depth int) (string, bool) {
+	for i := 0; i < val.NumField(); i++ {
+		field := val.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := w.fieldName(field)
+		if name == "" {
+			name = field.Name
+		}
+		if path != "" {
+			name = path + "." + name
+		}
+		if p, ok := w.exceeds(val.Field(i), name, depth+1); ok {
+			return p, true
+		}
+	}
+	return "", false
+}
+
+// listExceeds walks the elements of the slice or array val, found at path, at depth.
+func (w depthWalker) listExceeds(val reflect.Value, path string, depth int) (string, bool) {
+	for i := 0; i < val.Len(); i++ {
+		if p, ok := w.exceeds(val.Index(i), fmt.Sprintf("%s[%d]", path, i), depth); ok {
+			return p, true
+		}
+	}
+	return "", false
+}
+
+// mapExceeds walks the values of the map val, found at path, at depth in key order.
+func (w depthWalker) mapExceeds(val reflect.Value, path string, depth int) (string, bool) {
+	for _, key := range sortedMapKeys(val) {
+		if p, ok := w.exceeds(val.MapIndex(key), fmt.Sprintf("%s[%v]", path, key), depth); ok {
+			return p, true
+		}
+	}
+	return "", false
+}
+
+// enter dereferences the pointers and interfaces of val, walked with structs it holds at depth.
+// It reports false when val is nil or was already walked at depth.
+func (w depthWalker) enter(val reflect.Value, depth int) (reflect.Value, bool) {
+	for val.Kind() == reflect.Ptr || val.Kind() == reflect.Interface {
+		if val.IsNil() || !w.firstVisit(val, depth) {
+			return val, false
+		}
+		val = val.Elem()
+	}
+	return val, w.firstVisit(val, depth)
+}
+
+// firstVisit records that the pointer, slice or map val is walked with structs it holds at depth
+// and reports whether it's the first time. Values of other kinds always are.
+func (w depthWalker) firstVisit(val reflect.Value, depth int) bool {
+	key := visit{typ: val.Type(), depth: depth}
+	switch val.Kind() {
+	case reflect.Ptr, reflect.Map:
+		key.ptr = val.Pointer()
+	case reflect.Slice:
+		key.ptr, key.len = val.Pointer(), val.Len()
+	default:
+		return true
+	}
+
+	if _, ok := w.visited[key]; ok {
+		return false
+	}
+	w.visited[key] = struct{}{}
+	return true
+}
+
+// hasExportedFields reports whether the struct type typ declares at least one exported field.
+func hasExportedFields(typ reflect.Type) bool {
+	for i := 0; i < typ.NumField(); i++ {
+		if typ.Field(i).IsExported() {
+			return true
+		}
+	}
+	return false
+}
+
 // handleValidatorError processes and formats validation errors returned by the go-playground/validator.
 // It extracts detailed, field-specific error messages for structured reporting.
 //
 // Behavior:
-//   - If the error contains field-specific validation errors, they're formatted with
-//     field names, tags, and parameters where applicable.
+//   - If the error contains field-specific validation errors, they're returned as a
+//     ValidationError with field names, tags, and parameters where applicable.
+//   - If firstOnly is set, only the first field error is converted and returned.
 //   - If the error is not related to validation, it is returned as an unexpected error.
-func handleValidatorError(err error) error {
+func (val *Validator) handleValidatorError(err error, firstOnly bool) error {
 	var valErr validator.ValidationErrors
 	if errors.As(err, &valErr) {
-		var detailedErrors []string
+		if firstOnly && len(valErr) > 0 {
+			return ValidationError{Errors: []FieldError{val.newFieldError(valErr[0])}}
+		}
+		fieldErrors := make([]FieldError, 0, len(valErr))
 		for _, fe := range valErr {
-			if fe.StructField() != "" {
-				detailedErrors = append(
-					detailedErrors,
-					fmt.Sprintf("%s (%s=%s)", fe.StructNamespace(), fe.ActualTag(), fe.Param()),
-				)
-				continue
+			fieldErrors = append(fieldErrors, val.newFieldError(fe))
+		}
+		return ValidationError{Errors: fieldErrors}
+	}
+	return fmt.Errorf("unexpected validation error: %w", err)
+}
+
+// withCappedElements appends to err the errors of the values that exceeded their "slice_max_dive"
+// cap, validated with the tags following it, such as "dive,required". go-playground stops
+// validating a value at its first failed tag, so they would otherwise go unchecked.
+// Struct fields read their tags from typ, the type of the validated struct; a variable uses tag.
+// It must be called with val.mtx held.
+func (val *Validator) withCappedElements(ctx context.Context, err error, typ reflect.Type, tag string) error {
+	var valErr validator.ValidationErrors
+	if !errors.As(err, &valErr) {
+		return err
+	}
+
+	for _, fe := range valErr {
+		if fe.Tag() != "slice_max_dive" {
+			continue
+		}
+		fieldTag := tag
+		if fe.StructField() != "" {
+			fieldTag = structFieldTag(typ, fe.StructNamespace(), val.tagName)
+		}
+
+		var nested validator.ValidationErrors
+		if errors.As(val.validate.VarCtx(ctx, fe.Value(), tagsAfter(fieldTag, "slice_max_dive")), &nested) {
+			for _, nestedErr := range nested {
+				valErr = append(valErr, nestedFieldError{FieldError: nestedErr, parent: fe})
 			}
-			if fe.Value() == nil {
-				detailedErrors = append(
-					detailedErrors,
-					fmt.Sprintf("nil value (%s=%s)", fe.ActualTag(), fe.Param()),
-				)
-				continue
+		}
+	}
+	return valErr
+}
+
+// tagsAfter returns the comma-separated tags following the tag named name in tags.
+func tagsAfter(tags, name string) string {
+	parts := strings.Split(tags, ",")
+	for i, part := range parts {
+		if tagName, _, _ := strings.Cut(part, "="); tagName == name {
+			return strings.Join(parts[i+1:], ",")
+		}
+	}
+	return ""
+}
+
+// structFieldTag returns the tag named tagName of the struct field found at the Go namespace ns,
+// such as "Policy.Rules[0].Expressions", in the struct type typ, or "" when there's no such field.
+func structFieldTag(typ reflect.Type, ns, tagName string) string {
+	var field reflect.StructField
+	for _, segment := range strings.Split(ns, ".")[1:] {
+		for typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+		if typ.Kind() != reflect.Struct {
+			return ""
+		}
+		goName, _, _ := strings.Cut(segment, "[")
+		var ok bool
+		if field, ok = typ.FieldByName(goName); !ok {
+			return ""
+		}
+		typ = elemType(field.Type, strings.Count(segment, "["))
+	}
+	return field.Tag.Get(tagName)
+}
+
+// nestedFieldError is the error of a value validated on its own, such as an element of a slice,
+// reported under the namespace of its parent field.
+type nestedFieldError struct {
+	validator.FieldError
+	parent validator.FieldError
+}
+
+// Namespace returns the namespace of the value below its parent, e.g. "Policy.Expressions[1]".
+func (fe nestedFieldError) Namespace() string {
+	return fe.parent.Namespace() + fe.FieldError.Namespace()
+}
+
+// StructNamespace returns the Go namespace of the value below its parent.
+func (fe nestedFieldError) StructNamespace() string {
+	return fe.parent.StructNamespace() + fe.FieldError.StructNamespace()
+}
+
+// Field returns the name of the value below its parent, e.g. "Expressions[1]".
+func (fe nestedFieldError) Field() string {
+	return fe.parent.Field() + fe.FieldError.Field()
+}
+
+// StructField returns the Go name of the value below its parent.
+func (fe nestedFieldError) StructField() string {
+	return fe.parent.StructField() + fe.FieldError.StructField()
+}
+
+// newFieldError converts a go-playground field error, pointing at the offending element for
+// the tags located by elementLocator.
+func (val *Validator) newFieldError(fe validator.FieldError) FieldError {
+	out := FieldError{
+		Tag:     fe.ActualTag(),
+		Param:   fe.Param(),
+		Kind:    fe.Kind(),
+		Value:   fe.Value(),
+		message: val.formatFieldError(fe),
+	}
+	if fe.StructField() != "" {
+		out.Field = fe.Namespace()
+	}
+
+	if locate, ok := val.elementLocator(fe.Tag()); ok {
+		field := reflect.ValueOf(fe.Value())
+		if i := locate(field, fe.Param()); i >= 0 {
+			out.Value = field.Index(i).Interface()
+			out.Kind = field.Index(i).Kind()
+			if out.Field != "" {
+				out.Field = fmt.Sprintf("%s[%d]", out.Field, i)
 			}
-			detailedErrors = append(
-				detailedErrors,
-				fmt.Sprintf("%s %s (%s=%s)", fe.Type(), fe.Value(), fe.ActualTag(), fe.Param()),
-			)
 		}
-		return fmt.Errorf("validation failed: %s", strings.Join(detailedErrors, ", "))
 	}
-	return fmt.Errorf("unexpected validation error: %w", err)
+	return out
+}
+
+// elementLocator returns the function locating the offending element of a field failing tag:
+// the one listed in elementTags, or for "subset_of" one looking the enum up on this instance.
+func (val *Validator) elementLocator(tag string) (func(reflect.Value, string) int, bool) {
+	if tag == "subset_of" {
+		return func(field reflect.Value, name string) int {
+			return firstNotInEnum(field, val.enums[name])
+		}, true
+	}
+	locate, ok := elementTags[tag]
+	return locate, ok
+}
+
+// registerPattern compiles pattern and registers tag on val as a validation matching string values against it.
+// The compiled expression is captured by the registered function and therefore owned by val.
+func registerPattern(val *validator.Validate, tag, pattern string) error {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return fmt.Errorf("invalid pattern for tag %q: %w", tag, err)
+	}
+
+	return val.RegisterValidation(tag, func(fl validator.FieldLevel) bool {
+		return fl.Field().Kind() == reflect.String && re.MatchString(fl.Field().String())
+	})
+}
+
+// formatFieldError formats a single field error.
+// Errors of the tags listed in explainTags are followed by the reason the value was rejected.
+func (val *Validator) formatFieldError(fe validator.FieldError) string {
+	msg := val.describeFieldError(fe)
+	if explain, ok := explainTags[fe.Tag()]; ok {
+		if err := explain(reflect.ValueOf(fe.Value()), fe.Param()); err != nil {
+			msg += ": " + err.Error()
+		}
+	}
+	return msg
+}
+
+// maxErrorValueLength is the number of runes of a string value shown in struct field errors
+// before it's truncated.
+const maxErrorValueLength = 64
+
+// scalarSuffix returns " = <value>" for a bool, number or string value, quoting and truncating
+// strings, and "" for any other kind so that error messages don't dump complex values.
+func scalarSuffix(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return fmt.Sprintf(" = %v", v.Interface())
+	case reflect.String:
+		if runes := []rune(v.String()); len(runes) > maxErrorValueLength {
+			return fmt.Sprintf(" = %q...", string(runes[:maxErrorValueLength]))
+		}
+		return fmt.Sprintf(" = %q", v.String())
+	default:
+		return ""
+	}
+}
+
+// describeFieldError describes which field or value failed which tag.
+// Struct fields are reported by their namespace, named after the configured field name tag,
+// followed by their value when it's a scalar, e.g. "TestStruct.Field1 = 500 (gt=1024)".
+// Variables are reported by their type and value.
+// Errors of the tags located by elementLocator point at the first offending element instead of the whole slice.
+func (val *Validator) describeFieldError(fe validator.FieldError) string {
+	if locate, ok := val.elementLocator(fe.Tag()); ok {
+		field := reflect.ValueOf(fe.Value())
+		if i := locate(field, fe.Param()); i >= 0 {
+			elem := field.Index(i)
+			if fe.StructField() != "" {
+				name := fmt.Sprintf("%s[%d]", fe.Namespace(), i)
+				return fmt.Sprintf("%s%s (%s=%s)", name, scalarSuffix(elem), fe.ActualTag(), fe.Param())
+			}
+			return fmt.Sprintf("%s %v (%s=%s)", elem.Type(), elem.Interface(), fe.ActualTag(), fe.Param())
+		}
+	}
+
+	if fe.StructField() != "" {
+		return fmt.Sprintf("%s%s (%s=%s)", fe.Namespace(), scalarSuffix(reflect.ValueOf(fe.Value())), fe.ActualTag(), fe.Param())
+	}
+	if fe.Value() == nil {
+		return fmt.Sprintf("nil value (%s=%s)", fe.ActualTag(), fe.Param())
+	}
+	return fmt.Sprintf("%s %s (%s=%s)", fe.Type(), fe.Value(), fe.ActualTag(), fe.Param())
 }
@@ -1,7 +1,16 @@
 package val
 
 import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/stretchr/testify/assert"
@@ -21,13 +30,30 @@ type fieldSelectorInput struct {
 	Field string `validate:"k8s_field_selector"`
 }
 
+func TestScalarSuffix(t *testing.T) {
+	type valueInput struct {
+		Port    int      `validate:"gt=1024"`
+		Ratio   float64  `validate:"lte=1"`
+		Enabled bool     `validate:"eq=true"`
+		Name    string   `validate:"max=3"`
+		Tags    []string `validate:"min=1"`
+	}
+	long := strings.Repeat("a", maxErrorValueLength+1)
+	expectedErr := fmt.Sprintf("validation failed: valueInput.Port = 500 (gt=1024), valueInput.Ratio = 1.5 (lte=1), "+
+		"valueInput.Enabled = false (eq=true), valueInput.Name = %q... (max=3), valueInput.Tags (min=1)", long[:maxErrorValueLength])
+
+	err := ValidateStruct(valueInput{Port: 500, Ratio: 1.5, Name: long, Tags: []string{}})
+	require.Error(t, err)
+	assert.Equal(t, expectedErr, err.Error())
+}
+
 func TestHandleValidatorError(t *testing.T) {
 	t.Run("correct error", func(t *testing.T) {
 		a := TestStruct{Field2: "test"}
-		expectedErr := "validation failed: TestStruct.Field1 (required=), TestStruct.Field2 (oneof=debug info warn error)"
+		expectedErr := "validation failed: TestStruct.Field1 = 0 (required=), TestStruct.Field2 = \"test\" (oneof=debug info warn error)"
 
-		err := v.Struct(a)
-		resultErr := handleValidatorError(err)
+		err := defaultValidator.validate.Struct(a)
+		resultErr := defaultValidator.handleValidatorError(err, false)
 
 		require.Error(t, resultErr)
 		assert.Contains(t, resultErr.Error(), expectedErr)
@@ -35,7 +61,7 @@ func TestHandleValidatorError(t *testing.T) {
 
 	t.Run("unexpected error", func(t *testing.T) {
 		expectedErr := "unexpected validation error: assert.AnError general error for testing"
-		err := handleValidatorError(assert.AnError)
+		err := defaultValidator.handleValidatorError(assert.AnError, false)
 
 		require.Error(t, err)
 		assert.Equal(t, expectedErr, err.Error())
@@ -51,18 +77,23 @@ func TestValidateInputStruct(t *testing.T) {
 	})
 
 	t.Run("nil value", func(t *testing.T) {
-		expectedErr := "input is nil"
 		err := validateInputStruct(nil)
 
-		require.Error(t, err)
-		assert.Equal(t, expectedErr, err.Error())
+		require.ErrorIs(t, err, ErrNilInput)
 	})
 
 	t.Run("nil pointer value", func(t *testing.T) {
-		expectedErr := "input is a nil pointer"
 		err := validateInputStruct((*any)(nil))
 
-		require.Error(t, err)
+		require.ErrorIs(t, err, ErrNilPointer)
+	})
+
+	t.Run("not a struct value", func(t *testing.T) {
+		expectedErr := "input is not a struct: int"
+		n := 1
+
+		err := validateInputStruct(&n)
+		require.ErrorIs(t, err, ErrNotStruct)
 		assert.Equal(t, expectedErr, err.Error())
 	})
 }
@@ -77,7 +108,7 @@ func TestValidateStruct(t *testing.T) {
 
 	t.Run("error", func(t *testing.T) {
 		a := TestStruct{Field2: "test"}
-		expectedErr := "validation failed: TestStruct.Field1 (required=), TestStruct.Field2 (oneof=debug info warn error)"
+		expectedErr := "validation failed: TestStruct.Field1 = 0 (required=), TestStruct.Field2 = \"test\" (oneof=debug info warn error)"
 
 		err := ValidateStruct(a)
 		require.Error(t, err)
@@ -86,31 +117,121 @@ func TestValidateStruct(t *testing.T) {
 
 	t.Run("invalid input", func(t *testing.T) {
 		t.Run("nil value", func(t *testing.T) {
-			expectedErr := "input is nil"
 			err := ValidateStruct(nil)
 
-			require.Error(t, err)
-			assert.Equal(t, expectedErr, err.Error())
+			require.ErrorIs(t, err, ErrNilInput)
 		})
 
 		t.Run("nil pointer value", func(t *testing.T) {
-			expectedErr := "input is a nil pointer"
 			err := ValidateStruct((*any)(nil))
 
-			require.Error(t, err)
-			assert.Equal(t, expectedErr, err.Error())
+			require.ErrorIs(t, err, ErrNilPointer)
 		})
 
 		t.Run("not a struct value", func(t *testing.T) {
-			expectedErr := "unexpected validation error: validator: (nil int)"
 			err := ValidateStruct(1)
 
-			require.Error(t, err)
-			assert.Equal(t, expectedErr, err.Error())
+			require.ErrorIs(t, err, ErrNotStruct)
 		})
 	})
 }
 
+type tenantKey struct{}
+
+type tenantInput struct {
+	Tenant string `validate:"current_tenant"`
+}
+
+// decodeAndValidate shows ValidateStructT in generic caller code.
+func decodeAndValidate[T any](data string) (T, error) {
+	var v T
+	if err := json.Unmarshal([]byte(data), &v); err != nil {
+		return v, err
+	}
+	return v, ValidateStructT(v)
+}
+
+func TestValidateStructT(t *testing.T) {
+	t.Run("same as ValidateStruct", func(t *testing.T) {
+		require.NoError(t, ValidateStructT(TestStruct{Field1: 2048, Field2: "warn"}))
+
+		invalid := TestStruct{Field2: "test"}
+		err := ValidateStructT(invalid)
+		require.Error(t, err)
+		assert.Equal(t, ValidateStruct(invalid).Error(), err.Error())
+		assert.True(t, IsValidationError(err))
+	})
+
+	t.Run("generic caller", func(t *testing.T) {
+		v, err := decodeAndValidate[TestStruct](`{"Field1": 2048, "Field2": "info"}`)
+		require.NoError(t, err)
+		assert.Equal(t, TestStruct{Field1: 2048, Field2: "info"}, v)
+
+		_, err = decodeAndValidate[*TestStruct](`{"Field1": 80, "Field2": "info"}`)
+		require.True(t, IsValidationError(err))
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		require.ErrorIs(t, ValidateStructT((*TestStruct)(nil)), ErrNilPointer)
+		require.ErrorIs(t, ValidateStructT(42), ErrNotStruct)
+	})
+}
+
+func TestValidateStructCtx(t *testing.T) {
+	scoped := NewValidator()
+	require.NoError(t, scoped.RegisterValidationCtx("current_tenant", func(ctx context.Context, fl validator.FieldLevel) bool {
+		return fl.Field().String() == ctx.Value(tenantKey{})
+	}))
+	ctx := context.WithValue(context.Background(), tenantKey{}, "acme")
+
+	t.Run("no error", func(t *testing.T) {
+		require.NoError(t, scoped.ValidateStructCtx(ctx, tenantInput{Tenant: "acme"}))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		expectedErr := "validation failed: tenantInput.Tenant = \"globex\" (current_tenant=)"
+
+		err := scoped.ValidateStructCtx(ctx, &tenantInput{Tenant: "globex"})
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+
+	t.Run("package-level", func(t *testing.T) {
+		require.NoError(t, ValidateStructCtx(ctx, TestStruct{Field1: 2048, Field2: "warn"}))
+		require.Error(t, ValidateStructCtx(ctx, TestStruct{Field2: "test"}))
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		require.ErrorIs(t, ValidateStructCtx(ctx, nil), ErrNilInput)
+		require.ErrorIs(t, ValidateStructCtx(ctx, (*tenantInput)(nil)), ErrNilPointer)
+	})
+}
+
+func TestValidateWithTagCtx(t *testing.T) {
+	scoped := NewValidator()
+	require.NoError(t, scoped.RegisterValidationCtx("current_tenant", func(ctx context.Context, fl validator.FieldLevel) bool {
+		return fl.Field().String() == ctx.Value(tenantKey{})
+	}))
+	ctx := context.WithValue(context.Background(), tenantKey{}, "acme")
+
+	t.Run("no error", func(t *testing.T) {
+		require.NoError(t, scoped.ValidateWithTagCtx(ctx, "acme", "current_tenant"))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		expectedErr := "validation failed: string globex (current_tenant=)"
+
+		err := scoped.ValidateWithTagCtx(ctx, "globex", "current_tenant")
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+
+	t.Run("package-level", func(t *testing.T) {
+		require.NoError(t, ValidateWithTagCtx(ctx, "debug", "oneof=debug info warn error"))
+		require.Error(t, ValidateWithTagCtx(ctx, "qwe", "oneof=debug info warn error"))
+	})
+}
+
 func TestValidateWithTag(t *testing.T) {
 	t.Run("no error", func(t *testing.T) {
 		err := ValidateWithTag("debug", "oneof=debug info warn error")
@@ -134,6 +255,613 @@ func TestValidateWithTag(t *testing.T) {
 	})
 }
 
+func TestValidateStructFailFast(t *testing.T) {
+	t.Run("no error", func(t *testing.T) {
+		require.NoError(t, ValidateStructFailFast(TestStruct{Field1: 1025, Field2: "info"}))
+	})
+
+	t.Run("first error only", func(t *testing.T) {
+		expectedErr := "validation failed: TestStruct.Field1 = 0 (required=)"
+
+		err := ValidateStructFailFast(TestStruct{Field2: "test"})
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+
+		var valErr ValidationError
+		require.ErrorAs(t, err, &valErr)
+		require.Len(t, valErr.Errors, 1)
+		assert.Equal(t, "TestStruct.Field1", valErr.Errors[0].Field)
+		assert.Equal(t, "required", valErr.Errors[0].Tag)
+	})
+
+	t.Run("instance", func(t *testing.T) {
+		v := NewValidator()
+		require.True(t, IsValidationError(v.ValidateStructFailFast(wideInput{})))
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		require.ErrorIs(t, ValidateStructFailFast(nil), ErrNilInput)
+		require.ErrorIs(t, ValidateStructFailFast((*TestStruct)(nil)), ErrNilPointer)
+		require.ErrorIs(t, ValidateStructFailFast(1), ErrNotStruct)
+	})
+}
+
+func TestValidateStructs(t *testing.T) {
+	valid := TestStruct{Field1: 2048, Field2: "info"}
+
+	t.Run("all valid", func(t *testing.T) {
+		require.NoError(t, ValidateStructs([]any{valid, &valid}))
+		require.NoError(t, ValidateStructs(nil))
+	})
+
+	t.Run("indexed errors", func(t *testing.T) {
+		expectedErr := "items[1]: validation failed: TestStruct.Field1 = 0 (required=)\n" +
+			"items[3]: input is nil"
+
+		err := ValidateStructs([]any{valid, TestStruct{Field2: "warn"}, valid, nil})
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+		assert.True(t, IsValidationError(err))
+		assert.ErrorIs(t, err, ErrNilInput)
+
+		joined, ok := err.(interface{ Unwrap() []error })
+		require.True(t, ok)
+		assert.Len(t, joined.Unwrap(), 2)
+	})
+}
+
+func TestValidateMap(t *testing.T) {
+	rules := map[string]any{
+		"name": "required",
+		"spec": map[string]any{
+			"level":    "oneof=debug info",
+			"replicas": "gte=1",
+		},
+		"ports": map[string]any{"port": "gt=1024"},
+	}
+
+	t.Run("no error", func(t *testing.T) {
+		data := map[string]any{
+			"name":  "api",
+			"spec":  map[string]any{"level": "info", "replicas": 2},
+			"ports": []any{map[string]any{"port": 8080}},
+		}
+		require.NoError(t, ValidateMap(data, rules))
+	})
+
+	t.Run("missing key and failing oneof", func(t *testing.T) {
+		expectedErr := `validation failed: name (required=), ports[1].port = 80 (gt=1024), spec.level = "trace" (oneof=debug info)`
+		data := map[string]any{
+			"spec":  map[string]any{"level": "trace", "replicas": 1},
+			"ports": []map[string]any{{"port": 8080}, {"port": 80}},
+		}
+
+		err := ValidateMap(data, rules)
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+
+		fieldErrs, ok := AsFieldErrors(err)
+		require.True(t, ok)
+		assert.Equal(t, "spec.level", fieldErrs[2].Field)
+		assert.Equal(t, "oneof", fieldErrs[2].Tag)
+		assert.Equal(t, "trace", fieldErrs[2].Value)
+
+		out, err := json.Marshal(err)
+		require.NoError(t, err)
+		assert.Contains(t, string(out), `{"field":"spec.level","tag":"oneof","param":"debug info"}`)
+	})
+
+	t.Run("not a map", func(t *testing.T) {
+		expectedErr := "validation failed: ports (map=), spec (map=)"
+
+		err := ValidateMap(map[string]any{"name": "api", "spec": "debug", "ports": nil}, rules)
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+
+	t.Run("invalid rules", func(t *testing.T) {
+		err := ValidateMap(map[string]any{"name": "api"}, map[string]any{"name": 1})
+		require.EqualError(t, err, "rule of name must be a string or a map[string]any, got int")
+		assert.False(t, IsValidationError(err))
+	})
+}
+
+type signupAddress struct {
+	City string `json:"city" validate:"required"`
+}
+
+type signupInput struct {
+	Email    string        `json:"email" validate:"required,email"`
+	Password string        `json:"password" validate:"min=8"`
+	Address  signupAddress `json:"address"`
+}
+
+func TestValidateStructPartial(t *testing.T) {
+	input := signupInput{Email: "user@example.com", Password: "short"}
+
+	t.Run("no error", func(t *testing.T) {
+		require.NoError(t, ValidateStructPartial(input, "Email"))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		expectedErr := `validation failed: signupInput.password = "short" (min=8), signupInput.address.city = "" (required=)`
+
+		err := ValidateStructPartial(&input, "Password", "Address.City")
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		require.ErrorIs(t, ValidateStructPartial(nil, "Email"), ErrNilInput)
+		require.ErrorIs(t, ValidateStructPartial((*signupInput)(nil), "Email"), ErrNilPointer)
+		require.ErrorIs(t, ValidateStructPartial("signup", "Email"), ErrNotStruct)
+	})
+}
+
+func TestValidateStructExcept(t *testing.T) {
+	input := signupInput{Email: "user@example.com", Password: "long-enough"}
+
+	t.Run("no error", func(t *testing.T) {
+		require.NoError(t, ValidateStructExcept(input, "Address"))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		expectedErr := `validation failed: signupInput.email = "" (required=)`
+
+		err := ValidateStructExcept(signupInput{}, "Password", "Address")
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		require.ErrorIs(t, ValidateStructExcept(nil), ErrNilInput)
+		require.ErrorIs(t, ValidateStructExcept((*signupInput)(nil)), ErrNilPointer)
+	})
+}
+
+type filterNode struct {
+	Op       string `validate:"required"`
+	Children []filterNode
+	Named    map[string]*filterNode
+}
+
+func TestValidateStructMaxDepth(t *testing.T) {
+	// tree: root -> child -> grandchild
+	tree := filterNode{Op: "and", Children: []filterNode{
+		{Op: "or", Children: []filterNode{{Op: "eq"}}},
+	}}
+
+	t.Run("within limit", func(t *testing.T) {
+		require.NoError(t, ValidateStructMaxDepth(tree, 2))
+		require.NoError(t, ValidateStructMaxDepth(&tree, 3))
+	})
+
+	t.Run("beyond limit", func(t *testing.T) {
+		expectedErr := "validation failed: filterNode.Children[0].Children[0] (max_depth=1)"
+
+		err := ValidateStructMaxDepth(tree, 1)
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+		assert.True(t, IsValidationError(err))
+	})
+
+	t.Run("map of structs", func(t *testing.T) {
+		expectedErr := "validation failed: filterNode.Named[left] (max_depth=0)"
+
+		err := ValidateStructMaxDepth(filterNode{Op: "and", Named: map[string]*filterNode{"left": {Op: "eq"}}}, 0)
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+
+	t.Run("json field names", func(t *testing.T) {
+		type jsonTree struct {
+			Name     string     `json:"name"`
+			Children []jsonTree `json:"children,omitempty"`
+		}
+		deep := jsonTree{Children: []jsonTree{{Children: []jsonTree{{Name: "leaf"}}}}}
+
+		err := ValidateStructMaxDepth(deep, 1)
+		require.EqualError(t, err, "validation failed: jsonTree.children[0].children[0] (max_depth=1)")
+
+		out, err := json.Marshal(err)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"errors":[{"field":"children[0].children[0]","tag":"max_depth","param":"1"}]}`, string(out))
+
+		err = NewValidator(WithFieldNameTag("")).ValidateStructMaxDepth(deep, 1)
+		require.EqualError(t, err, "validation failed: jsonTree.Children[0].Children[0] (max_depth=1)")
+	})
+
+	t.Run("leaf structs", func(t *testing.T) {
+		type event struct {
+			Name string
+			At   time.Time
+			Seen *time.Time
+		}
+		now := time.Now()
+
+		require.NoError(t, ValidateStructMaxDepth(event{Name: "x", At: now, Seen: &now}, 0))
+	})
+
+	t.Run("anonymous root", func(t *testing.T) {
+		expectedErr := "validation failed: Child (max_depth=0)"
+
+		err := ValidateStructMaxDepth(struct{ Child filterNode }{Child: filterNode{Op: "eq"}}, 0)
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+
+	t.Run("cycles", func(t *testing.T) {
+		type holder struct {
+			Items  []any
+			Values map[string]any
+		}
+		items := []any{nil}
+		items[0] = items
+		values := map[string]any{}
+		values["self"] = values
+
+		require.NoError(t, ValidateStructMaxDepth(holder{Items: items, Values: values}, 0))
+
+		nested := []any{nil}
+		nested[0] = &holder{Items: nested}
+		expectedErr := "validation failed: holder.Items[0].Items[0] (max_depth=1)"
+
+		err := ValidateStructMaxDepth(holder{Items: nested}, 1)
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+
+	t.Run("runs normal validation", func(t *testing.T) {
+		expectedErr := "validation failed: filterNode.Op = \"\" (required=)"
+
+		err := ValidateStructMaxDepth(filterNode{Children: []filterNode{{Op: "eq"}}}, 5)
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		require.ErrorIs(t, ValidateStructMaxDepth(nil, 1), ErrNilInput)
+		require.EqualError(t, ValidateStructMaxDepth(tree, -1), "max depth must not be negative")
+	})
+}
+
+type streamItem struct {
+	Name  string `json:"name" validate:"required"`
+	Count int    `json:"count" validate:"gte=0"`
+}
+
+func TestValidateJSONArrayStream(t *testing.T) {
+	t.Run("invalid element", func(t *testing.T) {
+		input := `[{"name": "a", "count": 1}, {"count": -1}, {"name": "c"}]`
+
+		var indexes []int
+		var errs []error
+		err := ValidateJSONArrayStream(strings.NewReader(input), func(i int, item streamItem, err error) bool {
+			indexes = append(indexes, i)
+			errs = append(errs, err)
+			return true
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []int{0, 1, 2}, indexes)
+		assert.NoError(t, errs[0])
+		assert.EqualError(t, errs[1], "validation failed: streamItem.name = \"\" (required=), streamItem.count = -1 (gte=0)")
+		assert.NoError(t, errs[2])
+	})
+
+	t.Run("short-circuit", func(t *testing.T) {
+		input := `[{"name": "a"}, {}, {"name": "c"}]`
+
+		calls := 0
+		err := ValidateJSONArrayStream(strings.NewReader(input), func(i int, item streamItem, err error) bool {
+			calls++
+			return err == nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("mismatched element", func(t *testing.T) {
+		input := `[{"name": 1}, {"name": "b"}]`
+
+		var errs []error
+		err := ValidateJSONArrayStream(strings.NewReader(input), func(i int, item streamItem, err error) bool {
+			errs = append(errs, err)
+			return true
+		})
+		require.NoError(t, err)
+		require.Len(t, errs, 2)
+		assert.Error(t, errs[0])
+		assert.False(t, IsValidationError(errs[0]))
+		assert.NoError(t, errs[1])
+	})
+
+	t.Run("malformed stream", func(t *testing.T) {
+		noop := func(int, streamItem, error) bool { return true }
+
+		require.EqualError(t, ValidateJSONArrayStream(strings.NewReader(`{"name": "a"}`), noop), "read JSON array: unexpected {")
+		require.Error(t, ValidateJSONArrayStream(strings.NewReader(`[{"name": "a"},`), noop))
+		require.Error(t, ValidateJSONArrayStream(strings.NewReader(``), noop))
+	})
+}
+
+type fieldNameInput struct {
+	Field1 string `json:"field1,omitempty" yaml:"field_one" validate:"required"`
+	Field2 string `json:"-" validate:"required"`
+	Field3 string `validate:"required"`
+	Nested struct {
+		Level string `json:"level" validate:"oneof=debug info"`
+	} `json:"nested"`
+}
+
+func TestFieldNameTag(t *testing.T) {
+	input := fieldNameInput{}
+	input.Nested.Level = "trace"
+
+	t.Run("json by default", func(t *testing.T) {
+		expectedErr := "validation failed: fieldNameInput.field1 = \"\" (required=), fieldNameInput.Field2 = \"\" (required=), " +
+			"fieldNameInput.Field3 = \"\" (required=), fieldNameInput.nested.level = \"trace\" (oneof=debug info)"
+
+		err := ValidateStruct(input)
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		expectedErr := "validation failed: fieldNameInput.field_one = \"\" (required=), fieldNameInput.Field2 = \"\" (required=), " +
+			"fieldNameInput.Field3 = \"\" (required=), fieldNameInput.Nested.Level = \"trace\" (oneof=debug info)"
+
+		err := NewValidator(WithFieldNameTag("yaml")).ValidateStruct(input)
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+
+	t.Run("go field names", func(t *testing.T) {
+		expectedErr := "validation failed: fieldNameInput.Field1 = \"\" (required=), fieldNameInput.Field2 = \"\" (required=), " +
+			"fieldNameInput.Field3 = \"\" (required=), fieldNameInput.Nested.Level = \"trace\" (oneof=debug info)"
+
+		err := NewValidator(WithFieldNameTag("")).ValidateStruct(input)
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+}
+
+func TestValidationError(t *testing.T) {
+	t.Run("struct fields", func(t *testing.T) {
+		err := ValidateStruct(TestStruct{Field1: 80, Field2: "test"})
+
+		var valErr ValidationError
+		require.ErrorAs(t, err, &valErr)
+		require.Len(t, valErr.Errors, 2)
+
+		assert.Equal(t, "TestStruct.Field1", valErr.Errors[0].Field)
+		assert.Equal(t, "gt", valErr.Errors[0].Tag)
+		assert.Equal(t, "1024", valErr.Errors[0].Param)
+		assert.Equal(t, int64(80), valErr.Errors[0].Value)
+		assert.Equal(t, "TestStruct.Field1 = 80 (gt=1024)", valErr.Errors[0].Error())
+
+		assert.Equal(t, "TestStruct.Field2", valErr.Errors[1].Field)
+		assert.Equal(t, "oneof", valErr.Errors[1].Tag)
+		assert.Equal(t, "debug info warn error", valErr.Errors[1].Param)
+
+		expectedErr := "validation failed: TestStruct.Field1 = 80 (gt=1024), TestStruct.Field2 = \"test\" (oneof=debug info warn error)"
+		assert.Equal(t, expectedErr, err.Error())
+	})
+
+	t.Run("variable", func(t *testing.T) {
+		err := ValidateWithTag("qwe", "oneof=debug info warn error")
+
+		var valErr ValidationError
+		require.ErrorAs(t, err, &valErr)
+		require.Len(t, valErr.Errors, 1)
+		assert.Empty(t, valErr.Errors[0].Field)
+		assert.Equal(t, "qwe", valErr.Errors[0].Value)
+	})
+
+	t.Run("offending element", func(t *testing.T) {
+		require.NoError(t, RegisterEnum("validation-error-features", "metrics", "tracing"))
+		type config struct {
+			Features []string `validate:"subset_of=validation-error-features"`
+		}
+
+		var valErr ValidationError
+		require.ErrorAs(t, ValidateStruct(config{Features: []string{"metrics", "debug"}}), &valErr)
+		assert.Equal(t, "config.Features[1]", valErr.Errors[0].Field)
+		assert.Equal(t, "debug", valErr.Errors[0].Value)
+	})
+
+	t.Run("max depth", func(t *testing.T) {
+		tree := filterNode{Op: "and", Children: []filterNode{{Op: "eq"}}}
+
+		var valErr ValidationError
+		require.ErrorAs(t, ValidateStructMaxDepth(tree, 0), &valErr)
+		assert.Equal(t, FieldError{Field: "filterNode.Children[0]", Tag: "max_depth", Param: "0",
+			Kind: reflect.Struct, message: "filterNode.Children[0] (max_depth=0)"}, valErr.Errors[0])
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		assert.False(t, errors.As(ValidateStruct(nil), &ValidationError{}))
+	})
+}
+
+func TestValidationErrorJSON(t *testing.T) {
+	t.Run("struct", func(t *testing.T) {
+		input := fieldNameInput{Field2: "set", Field3: "set"}
+		input.Nested.Level = "trace"
+
+		data, err := json.Marshal(ValidateStruct(input))
+		require.NoError(t, err)
+
+		expected := `{"errors":[{"field":"field1","tag":"required","param":""},` +
+			`{"field":"nested.level","tag":"oneof","param":"debug info"}]}`
+		assert.JSONEq(t, expected, string(data))
+	})
+
+	t.Run("variable", func(t *testing.T) {
+		data, err := json.Marshal(ValidateWithTag(80, "gt=1024"))
+		require.NoError(t, err)
+
+		assert.JSONEq(t, `{"errors":[{"tag":"gt","param":"1024","value":80}]}`, string(data))
+	})
+
+	t.Run("go field names", func(t *testing.T) {
+		data, err := json.Marshal(NewValidator(WithFieldNameTag("")).ValidateStruct(TestStruct{Field1: 80, Field2: "info"}))
+		require.NoError(t, err)
+
+		assert.JSONEq(t, `{"errors":[{"field":"Field1","tag":"gt","param":"1024"}]}`, string(data))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		data, err := json.Marshal(ValidationError{})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"errors":[]}`, string(data))
+	})
+}
+
+func TestIsValidationError(t *testing.T) {
+	t.Run("struct validation error", func(t *testing.T) {
+		err := ValidateStruct(TestStruct{Field2: "test"})
+		require.Error(t, err)
+		assert.True(t, IsValidationError(err))
+	})
+
+	t.Run("tag validation error", func(t *testing.T) {
+		err := ValidateWithTag("qwe", "oneof=debug info warn error")
+		require.Error(t, err)
+		assert.True(t, IsValidationError(err))
+	})
+
+	t.Run("wrapped validation error", func(t *testing.T) {
+		err := ValidateWithTag("qwe", "oneof=debug info warn error")
+		require.Error(t, err)
+		assert.True(t, IsValidationError(fmt.Errorf("handler: %w", err)))
+	})
+
+	t.Run("nil input", func(t *testing.T) {
+		err := ValidateStruct(nil)
+		require.ErrorIs(t, err, ErrNilInput)
+		assert.False(t, IsValidationError(err))
+	})
+
+	t.Run("nil pointer", func(t *testing.T) {
+		err := ValidateStruct((*TestStruct)(nil))
+		require.ErrorIs(t, err, ErrNilPointer)
+		assert.False(t, IsValidationError(err))
+	})
+
+	t.Run("not a struct", func(t *testing.T) {
+		err := ValidateStruct(1)
+		require.ErrorIs(t, err, ErrNotStruct)
+		assert.False(t, IsValidationError(err))
+	})
+
+	t.Run("foreign error", func(t *testing.T) {
+		assert.False(t, IsValidationError(assert.AnError))
+		assert.False(t, IsValidationError(nil))
+	})
+}
+
+func TestSetErrorFormatter(t *testing.T) {
+	t.Cleanup(func() { SetErrorFormatter(nil) })
+	invalid := TestStruct{Field2: "test"}
+
+	SetErrorFormatter(func(fieldErrs []FieldError) string {
+		var b strings.Builder
+		b.WriteString("validation failed:")
+		for _, fe := range fieldErrs {
+			fmt.Fprintf(&b, "\n  • %s: %s", fe.Field, fe.Tag)
+		}
+		return b.String()
+	})
+
+	expectedErr := "validation failed:\n  • TestStruct.Field1: required\n  • TestStruct.Field2: oneof"
+	assert.EqualError(t, ValidateStruct(invalid), expectedErr)
+	assert.EqualError(t, ValidateWithTag("", "required"), "validation failed:\n  • : required")
+
+	SetErrorFormatter(nil)
+	expectedErr = "validation failed: TestStruct.Field1 = 0 (required=), TestStruct.Field2 = \"test\" (oneof=debug info warn error)"
+	assert.EqualError(t, ValidateStruct(invalid), expectedErr)
+}
+
+type bindingInput struct {
+	Name string `binding:"required"`
+	Port int    `binding:"gt=1024"`
+}
+
+func TestSetTagName(t *testing.T) {
+	v := NewValidator()
+	v.SetTagName("binding")
+	expectedErr := `validation failed: bindingInput.Name = "" (required=), bindingInput.Port = 80 (gt=1024)`
+
+	require.NoError(t, v.ValidateStruct(bindingInput{Name: "api", Port: 8080}))
+
+	err := v.ValidateStruct(bindingInput{Port: 80})
+	require.Error(t, err)
+	assert.Equal(t, expectedErr, err.Error())
+}
+
+type mapRulesInput struct {
+	Name string
+	Port int
+}
+
+func TestInstance(t *testing.T) {
+	t.Run("package-level", func(t *testing.T) {
+		assert.Same(t, defaultValidator.validate, Instance())
+	})
+
+	t.Run("struct validation map rules", func(t *testing.T) {
+		v := NewValidator()
+		v.Instance().RegisterStructValidationMapRules(map[string]string{
+			"Name": "required",
+			"Port": "gt=1024",
+		}, mapRulesInput{})
+
+		require.NoError(t, v.ValidateStruct(mapRulesInput{Name: "api", Port: 8080}))
+		require.Error(t, v.ValidateStruct(mapRulesInput{Name: "api", Port: 80}))
+	})
+}
+
+func TestAsFieldErrors(t *testing.T) {
+	t.Run("struct", func(t *testing.T) {
+		fieldErrs, ok := AsFieldErrors(ValidateStruct(TestStruct{Field1: 80, Field2: "trace"}))
+		require.True(t, ok)
+		require.Len(t, fieldErrs, 2)
+
+		assert.Equal(t, "TestStruct.Field1", fieldErrs[0].Field)
+		assert.Equal(t, "gt", fieldErrs[0].Tag)
+		assert.Equal(t, "1024", fieldErrs[0].Param)
+		assert.Equal(t, reflect.Int64, fieldErrs[0].Kind)
+		assert.Equal(t, int64(80), fieldErrs[0].Value)
+
+		assert.Equal(t, "TestStruct.Field2", fieldErrs[1].Field)
+		assert.Equal(t, "oneof", fieldErrs[1].Tag)
+		assert.Equal(t, "debug info warn error", fieldErrs[1].Param)
+		assert.Equal(t, reflect.String, fieldErrs[1].Kind)
+		assert.Equal(t, "trace", fieldErrs[1].Value)
+	})
+
+	t.Run("var", func(t *testing.T) {
+		fieldErrs, ok := AsFieldErrors(fmt.Errorf("handler: %w", ValidateWithTag(8080, "lt=1024")))
+		require.True(t, ok)
+		require.Len(t, fieldErrs, 1)
+
+		assert.Empty(t, fieldErrs[0].Field)
+		assert.Equal(t, "lt", fieldErrs[0].Tag)
+		assert.Equal(t, "1024", fieldErrs[0].Param)
+		assert.Equal(t, reflect.Int, fieldErrs[0].Kind)
+		assert.Equal(t, 8080, fieldErrs[0].Value)
+	})
+
+	t.Run("non-validation errors", func(t *testing.T) {
+		for _, err := range []error{nil, assert.AnError, ValidateStruct(nil)} {
+			fieldErrs, ok := AsFieldErrors(err)
+			assert.False(t, ok)
+			assert.Nil(t, fieldErrs)
+		}
+	})
+}
+
 func TestUrlPrefixValidator(t *testing.T) {
 	t.Run("positive", func(t *testing.T) {
 		err := ValidateWithTag("https://localhost:8081", "url_prefix")
@@ -191,6 +919,287 @@ func TestRegisterValidation(t *testing.T) {
 	})
 }
 
+func TestConcurrentRegisterAndValidate(t *testing.T) {
+	// Run with -race: registering tags while validating must not race on the shared validator.
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			tag := fmt.Sprintf("is-odd-%d", i)
+			assert.NoError(t, RegisterValidation(tag, func(fl validator.FieldLevel) bool {
+				return fl.Field().Int()%2 == 1
+			}))
+			assert.NoError(t, ValidateWithTag(3, tag))
+		}(i)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, ValidateStruct(TestStruct{Field1: 2048, Field2: "warn"}))
+			assert.NoError(t, ValidateWithTag("info", "oneof=debug info warn error"))
+		}()
+	}
+	wg.Wait()
+}
+
+func TestNewValidator(t *testing.T) {
+	t.Run("built-in and custom tags", func(t *testing.T) {
+		scoped := NewValidator()
+
+		require.NoError(t, scoped.ValidateStruct(TestStruct{Field1: 2048, Field2: "warn"}))
+		require.NoError(t, scoped.ValidateWithTag("Always", "k8s_pull_policy"))
+		require.Error(t, scoped.ValidateWithTag("always", "k8s_pull_policy"))
+	})
+
+	t.Run("registrations stay scoped", func(t *testing.T) {
+		first := NewValidator()
+		second := NewValidator()
+
+		require.NoError(t, first.RegisterValidation("scoped-tag", func(fl validator.FieldLevel) bool {
+			return fl.Field().String() == "first"
+		}))
+		require.NoError(t, second.RegisterPattern("scoped-tag", `^second$`))
+
+		require.NoError(t, first.ValidateWithTag("first", "scoped-tag"))
+		require.Error(t, first.ValidateWithTag("second", "scoped-tag"))
+		require.NoError(t, second.ValidateWithTag("second", "scoped-tag"))
+
+		assert.Panics(t, func() { _ = ValidateWithTag("first", "scoped-tag") })
+	})
+
+	t.Run("enums stay scoped", func(t *testing.T) {
+		first := NewValidator()
+		second := NewValidator()
+		require.NoError(t, first.RegisterEnum("scoped-feats", "metrics"))
+		require.NoError(t, second.RegisterEnum("scoped-feats", "tracing"))
+		expectedErr := "validation failed: string metrics (subset_of=scoped-feats)"
+
+		require.NoError(t, first.ValidateWithTag([]string{"metrics"}, "subset_of=scoped-feats"))
+		require.NoError(t, second.ValidateWithTag([]string{"tracing"}, "subset_of=scoped-feats"))
+		require.Error(t, ValidateWithTag([]string{"metrics"}, "subset_of=scoped-feats"))
+
+		err := second.ValidateWithTag([]string{"metrics", "tracing"}, "subset_of=scoped-feats")
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+
+	t.Run("struct rules stay scoped", func(t *testing.T) {
+		type resources struct {
+			Limits   map[string]string
+			Requests map[string]string
+		}
+		scoped := NewValidator()
+		require.NoError(t, scoped.RegisterQoSValidation(resources{}))
+		limitsOnly := resources{Limits: map[string]string{"cpu": "1"}}
+
+		require.Error(t, scoped.ValidateStruct(limitsOnly))
+		require.NoError(t, ValidateStruct(limitsOnly))
+		require.NoError(t, NewValidator().ValidateStruct(limitsOnly))
+	})
+
+	t.Run("tag name option", func(t *testing.T) {
+		type config struct {
+			Level string `check:"oneof=debug info" validate:"required"`
+		}
+		scoped := NewValidator(WithTagName("check"))
+
+		require.NoError(t, scoped.ValidateStruct(config{Level: "info"}))
+		require.Error(t, scoped.ValidateStruct(config{Level: "trace"}))
+		require.Error(t, ValidateStruct(config{}))
+	})
+}
+
+func TestRegisterValidationCtx(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		err := RegisterValidationCtx("allowed-namespace", func(ctx context.Context, fl validator.FieldLevel) bool {
+			allowed, _ := ctx.Value(tenantKey{}).([]string)
+			for _, namespace := range allowed {
+				if fl.Field().String() == namespace {
+					return true
+				}
+			}
+			return false
+		})
+		require.NoError(t, err)
+
+		ctx := context.WithValue(context.Background(), tenantKey{}, []string{"team-a", "team-b"})
+		require.NoError(t, ValidateWithTagCtx(ctx, "team-b", "allowed-namespace"))
+		require.Error(t, ValidateWithTagCtx(ctx, "kube-system", "allowed-namespace"))
+		require.Error(t, ValidateWithTag("team-a", "allowed-namespace"))
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("tag empty", func(t *testing.T) {
+			expectedErr := "function Key cannot be empty"
+
+			err := RegisterValidationCtx("", func(ctx context.Context, fl validator.FieldLevel) bool {
+				return true
+			})
+			require.Error(t, err)
+			assert.Equal(t, expectedErr, err.Error())
+		})
+
+		t.Run("func empty", func(t *testing.T) {
+			expectedErr := "function cannot be empty"
+
+			err := RegisterValidationCtx("allowed-namespace", nil)
+			require.Error(t, err)
+			assert.Equal(t, expectedErr, err.Error())
+		})
+	})
+}
+
+type aliasInput struct {
+	Port int `validate:"service_port"`
+}
+
+func TestRegisterAlias(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		require.NoError(t, RegisterAlias("service_port", "required,gt=1024,lt=65536"))
+
+		require.NoError(t, ValidateStruct(aliasInput{Port: 8080}))
+
+		expectedErr := "validation failed: aliasInput.Port = 80 (gt=1024)"
+		err := ValidateStruct(aliasInput{Port: 80})
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+
+		require.Error(t, ValidateStruct(aliasInput{}))
+		require.Error(t, ValidateWithTag(65536, "service_port"))
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		require.EqualError(t, RegisterAlias("", "required"), "alias cannot be empty")
+		require.EqualError(t, RegisterAlias("service_port", ""), `alias "service_port" must have at least one tag`)
+		require.Error(t, RegisterAlias("dive", "required"))
+	})
+}
+
+type nullStringInput struct {
+	Name sql.NullString `validate:"required,min=3"`
+}
+
+func TestRegisterCustomTypeFunc(t *testing.T) {
+	require.NoError(t, RegisterCustomTypeFunc(func(field reflect.Value) any {
+		if ns, ok := field.Interface().(sql.NullString); ok && ns.Valid {
+			return ns.String
+		}
+		return nil
+	}, sql.NullString{}))
+
+	t.Run("valid value", func(t *testing.T) {
+		require.NoError(t, ValidateStruct(nullStringInput{Name: sql.NullString{String: "api", Valid: true}}))
+	})
+
+	t.Run("null value", func(t *testing.T) {
+		expectedErr := "validation failed: nullStringInput.Name (required=)"
+
+		err := ValidateStruct(nullStringInput{Name: sql.NullString{String: "api"}})
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+
+	t.Run("inner value", func(t *testing.T) {
+		expectedErr := `validation failed: nullStringInput.Name = "ab" (min=3)`
+
+		err := ValidateStruct(nullStringInput{Name: sql.NullString{String: "ab", Valid: true}})
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+
+	t.Run("invalid registration", func(t *testing.T) {
+		fn := func(field reflect.Value) any { return nil }
+
+		require.EqualError(t, RegisterCustomTypeFunc(nil, sql.NullString{}), "function cannot be empty")
+		require.EqualError(t, RegisterCustomTypeFunc(fn), "at least one type must be provided")
+		require.ErrorIs(t, RegisterCustomTypeFunc(fn, nil), ErrNilInput)
+	})
+}
+
+func TestRegisterPattern(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		require.NoError(t, RegisterPattern("ticket-id", `^[A-Z]+-[0-9]+$`))
+
+		require.NoError(t, ValidateWithTag("VAL-42", "ticket-id"))
+
+		expectedErr := "validation failed: string val-42 (ticket-id=)"
+		err := ValidateWithTag("val-42", "ticket-id")
+		require.Error(t, err)
+		assert.Equal(t, expectedErr, err.Error())
+	})
+
+	t.Run("not a string", func(t *testing.T) {
+		require.NoError(t, RegisterPattern("digits-only", `^[0-9]+$`))
+
+		require.Error(t, ValidateWithTag(42, "digits-only"))
+	})
+
+	t.Run("instances keep their own patterns", func(t *testing.T) {
+		first := NewValidator()
+		second := NewValidator()
+		require.NoError(t, first.RegisterPattern("code", `^[a-z]+$`))
+		require.NoError(t, second.RegisterPattern("code", `^[0-9]+$`))
+
+		require.NoError(t, first.ValidateWithTag("abc", "code"))
+		require.Error(t, first.ValidateWithTag("123", "code"))
+
+		require.NoError(t, second.ValidateWithTag("123", "code"))
+		require.Error(t, second.ValidateWithTag("abc", "code"))
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("invalid pattern", func(t *testing.T) {
+			expectedErr := "invalid pattern for tag \"broken\": error parsing regexp: missing closing ]: `[a-z`"
+
+			err := RegisterPattern("broken", `[a-z`)
+			require.Error(t, err)
+			assert.Equal(t, expectedErr, err.Error())
+		})
+
+		t.Run("tag empty", func(t *testing.T) {
+			expectedErr := "function Key cannot be empty"
+
+			err := RegisterPattern("", `^a$`)
+			require.Error(t, err)
+			assert.Equal(t, expectedErr, err.Error())
+		})
+	})
+}
+
+func TestRegisterEnum(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		require.NoError(t, RegisterEnum("test-levels", "debug", "info"))
+
+		err := ValidateWithTag([]string{"info"}, "subset_of=test-levels")
+		require.NoError(t, err)
+	})
+
+	t.Run("replace values", func(t *testing.T) {
+		require.NoError(t, RegisterEnum("test-replaced", "old"))
+		require.NoError(t, RegisterEnum("test-replaced", "new"))
+
+		require.NoError(t, ValidateWithTag([]string{"new"}, "subset_of=test-replaced"))
+		require.Error(t, ValidateWithTag([]string{"old"}, "subset_of=test-replaced"))
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("name empty", func(t *testing.T) {
+			expectedErr := "enum name cannot be empty"
+
+			err := RegisterEnum("", "a")
+			require.Error(t, err)
+			assert.Equal(t, expectedErr, err.Error())
+		})
+
+		t.Run("values empty", func(t *testing.T) {
+			expectedErr := `enum "test-empty" must have at least one value`
+
+			err := RegisterEnum("test-empty")
+			require.Error(t, err)
+			assert.Equal(t, expectedErr, err.Error())
+		})
+	})
+}
+
 func TestLabelSelectorValidator_AllSyntax(t *testing.T) {
 	v := validator.New()
 	labelSelectorValidator(v)
@@ -264,3 +1273,63 @@ func TestFieldSelectorValidator_AllSyntax(t *testing.T) {
 		}
 	}
 }
+
+// BenchmarkValidateStructParallel measures validation throughput under concurrency,
+// e.g. go test -bench ValidateStructParallel -cpu 1,4,8.
+func BenchmarkValidateStructParallel(b *testing.B) {
+	s := TestStruct{Field1: 2048, Field2: "warn"}
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if err := ValidateStruct(s); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// wideInput has 20 failing fields to measure the cost of building validation errors.
+type wideInput struct {
+	F01 string `validate:"required"`
+	F02 string `validate:"required"`
+	F03 string `validate:"required"`
+	F04 string `validate:"required"`
+	F05 string `validate:"required"`
+	F06 int    `validate:"gte=1"`
+	F07 int    `validate:"gte=1"`
+	F08 int    `validate:"gte=1"`
+	F09 int    `validate:"gte=1"`
+	F10 int    `validate:"gte=1"`
+	F11 string `validate:"oneof=debug info warn error"`
+	F12 string `validate:"oneof=debug info warn error"`
+	F13 string `validate:"oneof=debug info warn error"`
+	F14 string `validate:"oneof=debug info warn error"`
+	F15 string `validate:"oneof=debug info warn error"`
+	F16 string `validate:"min=3"`
+	F17 string `validate:"min=3"`
+	F18 string `validate:"min=3"`
+	F19 string `validate:"min=3"`
+	F20 string `validate:"min=3"`
+}
+
+func BenchmarkValidateStructFailFast(b *testing.B) {
+	s := wideInput{}
+
+	b.Run("full", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if err := ValidateStruct(s); err == nil {
+				b.Fatal("expected validation error")
+			}
+		}
+	})
+
+	b.Run("fail fast", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if err := ValidateStructFailFast(s); err == nil {
+				b.Fatal("expected validation error")
+			}
+		}
+	})
+}